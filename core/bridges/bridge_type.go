@@ -1,8 +1,10 @@
 package bridges
 
 import (
+	"crypto/ed25519"
 	"crypto/subtle"
 	"database/sql/driver"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -10,6 +12,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -21,6 +25,31 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// CacheTTL is how long a successful response is served from the cache
+	// instead of calling the bridge again; zero disables caching
+	CacheTTL models.Interval `json:"cacheTTL"`
+	// StaleIfError is how long after CacheTTL expires the last successful
+	// response may still be used if the bridge returns an error
+	StaleIfError models.Interval `json:"staleIfError"`
+	// Timeout bounds each request to the bridge, overriding the default HTTP
+	// timeout; zero uses the default
+	Timeout models.Interval `json:"timeout"`
+	// Retries is how many times a failed request is retried before the task
+	// fails, for errors that may succeed on retry
+	Retries uint32 `json:"retries"`
+	// CircuitBreakerThreshold is the number of consecutive failed requests
+	// after which requests fail fast without calling the bridge; zero
+	// disables the circuit breaker
+	CircuitBreakerThreshold uint32 `json:"circuitBreakerThreshold"`
+	// CircuitBreakerCooldown is how long requests fail fast before a probe
+	// request is let through; zero uses the default of 30s
+	CircuitBreakerCooldown models.Interval `json:"circuitBreakerCooldown"`
+	// RequestSigning is how requests to the bridge are signed; empty
+	// disables signing
+	RequestSigning RequestSigning `json:"requestSigning"`
+	// ResponsePublicKey is the hex encoded ed25519 public key that responses
+	// from the bridge must be signed with; empty disables verification
+	ResponsePublicKey string `json:"responsePublicKey"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -53,15 +82,23 @@ type BridgeTypeAuthentication struct {
 // BridgeType is used for external adapters and has fields for
 // the name of the adapter and its URL.
 type BridgeType struct {
-	Name                   TaskType
-	URL                    models.WebURL
-	Confirmations          uint32
-	IncomingTokenHash      string
-	Salt                   string
-	OutgoingToken          string
-	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	Name                    TaskType
+	URL                     models.WebURL
+	Confirmations           uint32
+	IncomingTokenHash       string
+	Salt                    string
+	OutgoingToken           string
+	MinimumContractPayment  *assets.Link
+	CacheTTL                models.Interval
+	StaleIfError            models.Interval
+	Timeout                 models.Interval
+	Retries                 uint32
+	CircuitBreakerThreshold uint32
+	CircuitBreakerCooldown  models.Interval
+	RequestSigning          RequestSigning
+	ResponsePublicKey       string
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -85,13 +122,21 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
 		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
+			Name:                    btr.Name,
+			URL:                     btr.URL,
+			Confirmations:           btr.Confirmations,
+			IncomingTokenHash:       hash,
+			Salt:                    salt,
+			OutgoingToken:           outgoingToken,
+			MinimumContractPayment:  btr.MinimumContractPayment,
+			CacheTTL:                btr.CacheTTL,
+			StaleIfError:            btr.StaleIfError,
+			Timeout:                 btr.Timeout,
+			Retries:                 btr.Retries,
+			CircuitBreakerThreshold: btr.CircuitBreakerThreshold,
+			CircuitBreakerCooldown:  btr.CircuitBreakerCooldown,
+			RequestSigning:          btr.RequestSigning,
+			ResponsePublicKey:       btr.ResponsePublicKey,
 		}, nil
 }
 
@@ -114,6 +159,43 @@ func incomingTokenHash(token, salt string) (string, error) {
 	return hash, nil
 }
 
+// RequestSigning is how the node signs requests to a bridge, so the bridge can
+// authenticate them.
+type RequestSigning string
+
+const (
+	// RequestSigningNone sends requests unsigned.
+	RequestSigningNone RequestSigning = ""
+	// RequestSigningHMAC signs requests with HMAC-SHA256, keyed with the
+	// bridge's outgoing token.
+	RequestSigningHMAC RequestSigning = "hmac"
+	// RequestSigningCSA signs requests with the node's CSA key.
+	RequestSigningCSA RequestSigning = "csa"
+)
+
+// Validate returns an error if s is not a known signing method.
+func (s RequestSigning) Validate() error {
+	switch s {
+	case RequestSigningNone, RequestSigningHMAC, RequestSigningCSA:
+		return nil
+	default:
+		return fmt.Errorf("unknown request signing method %q, must be one of %q or %q", s, RequestSigningHMAC, RequestSigningCSA)
+	}
+}
+
+// ParseResponsePublicKey decodes the hex encoded ed25519 public key that
+// signs the responses of a bridge.
+func ParseResponsePublicKey(key string) (ed25519.PublicKey, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "response public key must be hex encoded")
+	}
+	if len(b) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("response public key must be %d bytes, got %d", ed25519.PublicKeySize, len(b))
+	}
+	return ed25519.PublicKey(b), nil
+}
+
 // NOTE: latestAnswer and updatedAt is the only metadata used.
 // Currently market closer adapter and outlier detection depend latestAnswer.
 // https://github.com/smartcontractkit/external-adapters-js/tree/f474bd2e2de13ebe5c9dc3df36ebb7018817005e/composite/market-closure
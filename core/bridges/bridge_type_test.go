@@ -36,3 +36,30 @@ func TestBridgeType_Authenticate(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestSigning_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []bridges.RequestSigning{bridges.RequestSigningNone, bridges.RequestSigningHMAC, bridges.RequestSigningCSA} {
+		assert.NoError(t, s.Validate())
+	}
+	assert.EqualError(t, bridges.RequestSigning("rsa").Validate(), `unknown request signing method "rsa", must be one of "hmac" or "csa"`)
+}
+
+func TestParseResponsePublicKey(t *testing.T) {
+	t.Parallel()
+
+	key := "c2a28d6f1dd7d1c5e0d4e0d1f1c2ad5e67b3c3c9f7bc0ef1b0e0a6b7dfa4a0c3"
+	pk, err := bridges.ParseResponsePublicKey(key)
+	require.NoError(t, err)
+	assert.Len(t, pk, 32)
+
+	pk2, err := bridges.ParseResponsePublicKey("0x" + key)
+	require.NoError(t, err)
+	assert.Equal(t, pk, pk2)
+
+	_, err = bridges.ParseResponsePublicKey("zz")
+	assert.EqualError(t, err, "response public key must be hex encoded: encoding/hex: invalid byte: U+007A 'z'")
+	_, err = bridges.ParseResponsePublicKey("abcd")
+	assert.EqualError(t, err, "response public key must be 32 bytes, got 2")
+}
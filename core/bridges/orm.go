@@ -80,8 +80,8 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, cache_ttl, stale_if_error, timeout, retries, circuit_breaker_threshold, circuit_breaker_cooldown, request_signing, response_public_key, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :cache_ttl, :stale_if_error, :timeout, :retries, :circuit_breaker_threshold, :circuit_breaker_cooldown, :request_signing, :response_public_key, now(), now())
 	RETURNING *;`
 	err := o.q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
@@ -96,8 +96,13 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(bt *BridgeType,
 	btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	sql := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, cache_ttl = $4, stale_if_error = $5,
+	timeout = $6, retries = $7, circuit_breaker_threshold = $8, circuit_breaker_cooldown = $9,
+	request_signing = $10, response_public_key = $11
+	WHERE name = $12 RETURNING *`
+	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, btr.CacheTTL, btr.StaleIfError,
+		btr.Timeout, btr.Retries, btr.CircuitBreakerThreshold, btr.CircuitBreakerCooldown,
+		btr.RequestSigning, btr.ResponsePublicKey, bt.Name)
 }
 
 // --- External Initiator
@@ -1189,8 +1189,18 @@ func saveAttemptWithNewState(q pg.Queryer, lggr logger.Logger, attempt EthTxAtte
 //
 // If any of the confirmed transactions does not have a receipt in the chain, it has been
 // re-org'd out and will be rebroadcast.
+//
+// If EvmFinalityTagEnabled is set and the chain contains a finalized head, only
+// transactions confirmed at or above that head are checked.
 func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Context, head *evmtypes.Head) error {
-	if head.ChainLength() < ec.config.EvmFinalityDepth() {
+	lowBlockNumber := head.EarliestInChain().Number
+	finalizedHead := head.LatestFinalizedHead()
+	if ec.config.EvmFinalityTagEnabled() && finalizedHead != nil {
+		// transactions confirmed in finalized blocks cannot be re-orged out,
+		// so only the blocks after the latest finalized one need to be checked
+		lowBlockNumber = finalizedHead.Number
+		ec.nConsecutiveBlocksChainTooShort = 0
+	} else if head.ChainLength() < ec.config.EvmFinalityDepth() {
 		logArgs := []interface{}{
 			"evmChainID", ec.chainID.String(), "chainLength", head.ChainLength(), "evmFinalityDepth", ec.config.EvmFinalityDepth(),
 		}
@@ -1205,7 +1215,7 @@ func (ec *EthConfirmer) EnsureConfirmedTransactionsInLongestChain(ctx context.Co
 	} else {
 		ec.nConsecutiveBlocksChainTooShort = 0
 	}
-	etxs, err := findTransactionsConfirmedInBlockRange(ec.q, ec.lggr, head.Number, head.EarliestInChain().Number, ec.chainID)
+	etxs, err := findTransactionsConfirmedInBlockRange(ec.q, ec.lggr, head.Number, lowBlockNumber, ec.chainID)
 	if err != nil {
 		return errors.Wrap(err, "findTransactionsConfirmedInBlockRange failed")
 	}
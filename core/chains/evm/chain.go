@@ -18,6 +18,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
@@ -33,6 +34,9 @@ type Chain interface {
 	Client() evmclient.Client
 	Config() evmconfig.ChainScopedConfig
 	LogBroadcaster() log.Broadcaster
+	// LogPoller returns the chain's log poller, or nil if FEATURE_LOG_POLLER
+	// is disabled.
+	LogPoller() logpoller.LogPoller
 	HeadBroadcaster() httypes.HeadBroadcaster
 	TxManager() bulletprooftxmanager.TxManager
 	HeadTracker() httypes.HeadTracker
@@ -52,6 +56,7 @@ type chain struct {
 	headBroadcaster httypes.HeadBroadcaster
 	headTracker     httypes.HeadTracker
 	logBroadcaster  log.Broadcaster
+	logPoller       logpoller.LogPoller
 	balanceMonitor  balancemonitor.BalanceMonitor
 	keyStore        keystore.Eth
 }
@@ -148,6 +153,11 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 
 	headBroadcaster.Subscribe(logBroadcaster)
 
+	var logPoller logpoller.LogPoller
+	if cfg.EVMRPCEnabled() && cfg.FeatureLogPoller() {
+		logPoller = logpoller.NewLogPoller(logpoller.NewORM(db, l, cfg, *chainID), client, cfg, l)
+	}
+
 	c := chain{
 		utils.StartStopOnce{},
 		chainID,
@@ -158,6 +168,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		headBroadcaster,
 		headTracker,
 		logBroadcaster,
+		logPoller,
 		balanceMonitor,
 		opts.KeyStore,
 	}
@@ -183,6 +194,9 @@ func (c *chain) Start() error {
 		if c.balanceMonitor != nil {
 			merr = multierr.Combine(merr, c.balanceMonitor.Start())
 		}
+		if c.logPoller != nil {
+			merr = multierr.Combine(merr, c.logPoller.Start())
+		}
 
 		if merr != nil {
 			return merr
@@ -230,6 +244,10 @@ func (c *chain) Close() error {
 			c.logger.Debug("Chain: stopping balance monitor")
 			merr = c.balanceMonitor.Close()
 		}
+		if c.logPoller != nil {
+			c.logger.Debug("Chain: stopping logPoller")
+			merr = multierr.Combine(merr, c.logPoller.Close())
+		}
 		c.logger.Debug("Chain: stopping logBroadcaster")
 		merr = multierr.Combine(merr, c.logBroadcaster.Close())
 		c.logger.Debug("Chain: stopping headTracker")
@@ -256,6 +274,9 @@ func (c *chain) Ready() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Ready())
 	}
+	if c.logPoller != nil {
+		merr = multierr.Combine(merr, c.logPoller.Ready())
+	}
 	return
 }
 
@@ -270,6 +291,9 @@ func (c *chain) Healthy() (merr error) {
 	if c.balanceMonitor != nil {
 		merr = multierr.Combine(merr, c.balanceMonitor.Healthy())
 	}
+	if c.logPoller != nil {
+		merr = multierr.Combine(merr, c.logPoller.Healthy())
+	}
 	return
 }
 
@@ -277,6 +301,7 @@ func (c *chain) ID() *big.Int                                  { return c.id }
 func (c *chain) Client() evmclient.Client                      { return c.client }
 func (c *chain) Config() evmconfig.ChainScopedConfig           { return c.cfg }
 func (c *chain) LogBroadcaster() log.Broadcaster               { return c.logBroadcaster }
+func (c *chain) LogPoller() logpoller.LogPoller                { return c.logPoller }
 func (c *chain) HeadBroadcaster() httypes.HeadBroadcaster      { return c.headBroadcaster }
 func (c *chain) TxManager() bulletprooftxmanager.TxManager     { return c.txm }
 func (c *chain) HeadTracker() httypes.HeadTracker              { return c.headTracker }
@@ -43,6 +43,8 @@ type Client interface {
 	// correct hash from the RPC response.
 	HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error)
 	SubscribeNewHead(ctx context.Context, ch chan<- *evmtypes.Head) (ethereum.Subscription, error)
+	// NodeHeads returns the latest head number reported by each live node
+	NodeHeads(ctx context.Context) []NodeHead
 
 	// Wrapped Geth client methods
 	SendTransaction(ctx context.Context, tx *types.Transaction) error
@@ -64,6 +66,14 @@ type Client interface {
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 }
 
+// NodeHead is the latest head number reported by a node, or the error
+// returned when fetching it
+type NodeHead struct {
+	Name   string
+	Number int64
+	Err    error
+}
+
 // This interface only exists so that we can generate a mock for it.  It is
 // identical to `ethereum.Subscription`.
 type Subscription interface {
@@ -174,6 +184,10 @@ func (client *client) ChainID() *big.Int {
 	return client.pool.chainID
 }
 
+func (client *client) NodeHeads(ctx context.Context) []NodeHead {
+	return client.pool.NodeHeads(ctx)
+}
+
 func (client *client) HeaderByNumber(ctx context.Context, n *big.Int) (*types.Header, error) {
 	return client.pool.HeaderByNumber(ctx, n)
 }
@@ -229,9 +243,21 @@ func (client *client) HeadByNumber(ctx context.Context, number *big.Int) (head *
 	return
 }
 
+// FinalizedBlockNumber can be passed as a block number to HeadByNumber to get
+// the latest finalized block, on chains that support the "finalized" tag. It
+// matches go-ethereum's rpc.FinalizedBlockNumber.
+const FinalizedBlockNumber int64 = -3
+
+// ToBlockNumArg converts a block number to an RPC block parameter. As in
+// go-ethereum, nil means "latest", -1 means "pending" and
+// FinalizedBlockNumber means "finalized".
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
+	} else if number.Cmp(big.NewInt(-1)) == 0 {
+		return "pending"
+	} else if number.Cmp(big.NewInt(FinalizedBlockNumber)) == 0 {
+		return "finalized"
 	}
 	return hexutil.EncodeBig(number)
 }
@@ -103,6 +103,10 @@ func (e *erroringNode) EthSubscribe(ctx context.Context, channel interface{}, ar
 	return nil, errors.New(e.errMsg)
 }
 
+func (e *erroringNode) Name() string {
+	return "<erroring node>"
+}
+
 func (e *erroringNode) String() string {
 	return "<erroring node>"
 }
@@ -45,6 +45,7 @@ type Node interface {
 	EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error)
 	ChainID(ctx context.Context) (chainID *big.Int, err error)
 
+	Name() string
 	String() string
 }
 
@@ -552,6 +553,10 @@ func switching(n *node) string {
 	return "websocket"
 }
 
+func (n *node) Name() string {
+	return n.name
+}
+
 func (n *node) String() string {
 	s := fmt.Sprintf("(primary)%s:%s", n.name, n.ws.uri.String())
 	if n.http != nil {
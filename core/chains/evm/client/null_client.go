@@ -71,6 +71,11 @@ func (nc *NullClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.H
 	return nil, nil
 }
 
+func (nc *NullClient) NodeHeads(ctx context.Context) []NodeHead {
+	nc.lggr.Debug("NodeHeads")
+	return nil
+}
+
 type nullSubscription struct {
 	lggr logger.Logger
 }
@@ -155,6 +155,23 @@ func (p *Pool) liveNodes() (liveNodes []Node) {
 	return
 }
 
+// NodeHeads returns the latest head number reported by each live node
+func (p *Pool) NodeHeads(ctx context.Context) (heads []NodeHead) {
+	for _, n := range p.liveNodes() {
+		nh := NodeHead{Name: n.Name()}
+		header, err := n.HeaderByNumber(ctx, nil)
+		if err != nil {
+			nh.Err = err
+		} else if header == nil {
+			nh.Err = errors.New("got nil header")
+		} else {
+			nh.Number = header.Number.Int64()
+		}
+		heads = append(heads, nh)
+	}
+	return
+}
+
 func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	return p.getRoundRobin().CallContext(ctx, result, method, args...)
 }
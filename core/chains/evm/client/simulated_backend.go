@@ -219,6 +219,11 @@ func (c *SimulatedBackendClient) blockNumber(number interface{}) (blockNumber *b
 }
 
 // HeadByNumber returns our own header type.
+// NodeHeads returns the current block of the simulated backend as its only node
+func (c *SimulatedBackendClient) NodeHeads(ctx context.Context) []NodeHead {
+	return []NodeHead{{Name: "simulated", Number: c.currentBlockNumber().Int64()}}
+}
+
 func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (*evmtypes.Head, error) {
 	if n == nil {
 		n = c.currentBlockNumber()
@@ -35,6 +35,7 @@ type (
 		ethTxReaperThreshold                           time.Duration
 		ethTxResendAfterThreshold                      time.Duration
 		finalityDepth                                  uint32
+		finalityTagEnabled                             bool
 		flagsContractAddress                           string
 		gasBumpPercent                                 uint16
 		gasBumpThreshold                               uint64
@@ -53,6 +54,7 @@ type (
 		headTrackerSamplingInterval                    time.Duration
 		linkContractAddress                            string
 		logBackfillBatchSize                           uint32
+		logPollInterval                                time.Duration
 		maxGasPriceWei                                 big.Int
 		maxInFlightTransactions                        uint32
 		maxQueuedTransactions                          uint64
@@ -107,6 +109,7 @@ func setChainSpecificConfigDefaultSets() {
 		ethTxReaperThreshold:                  168 * time.Hour,
 		ethTxResendAfterThreshold:             1 * time.Minute,
 		finalityDepth:                         50,
+		finalityTagEnabled:                    false,
 		gasBumpPercent:                        20,
 		gasBumpThreshold:                      3,
 		gasBumpTxDepth:                        10,
@@ -124,6 +127,7 @@ func setChainSpecificConfigDefaultSets() {
 		headTrackerSamplingInterval:           1 * time.Second,
 		linkContractAddress:                   "",
 		logBackfillBatchSize:                  100,
+		logPollInterval:                       15 * time.Second,
 		maxGasPriceWei:                        *assets.GWei(5000),
 		maxInFlightTransactions:               16,
 		maxQueuedTransactions:                 250,
@@ -187,6 +191,7 @@ func setChainSpecificConfigDefaultSets() {
 	bscMainnet.headTrackerHistoryDepth = 100
 	bscMainnet.headTrackerSamplingInterval = 1 * time.Second
 	bscMainnet.linkContractAddress = "0x404460c6a5ede2d891e8297795264fde62adbb75"
+	bscMainnet.logPollInterval = 3 * time.Second
 	bscMainnet.minGasPriceWei = *assets.GWei(1)
 	bscMainnet.minIncomingConfirmations = 3
 	bscMainnet.minRequiredOutgoingConfirmations = 12
@@ -214,6 +219,7 @@ func setChainSpecificConfigDefaultSets() {
 	polygonMainnet.blockHistoryEstimatorBlockDelay = 10        // Must be set to something large here because Polygon has so many re-orgs that otherwise we are constantly refetching
 	polygonMainnet.blockHistoryEstimatorBlockHistorySize = 24
 	polygonMainnet.linkContractAddress = "0xb0897686c545045afc77cf20ec7a532e3120e0f1"
+	polygonMainnet.logPollInterval = 1 * time.Second
 	polygonMainnet.minIncomingConfirmations = 5
 	polygonMainnet.minRequiredOutgoingConfirmations = 12
 	polygonMumbai := polygonMainnet
@@ -279,6 +285,7 @@ func setChainSpecificConfigDefaultSets() {
 	// Avalanche
 	avalancheMainnet := fallbackDefaultSet
 	avalancheMainnet.linkContractAddress = "0x5947BB275c521040051D82396192181b413227A3"
+	avalancheMainnet.logPollInterval = 2 * time.Second
 	avalancheMainnet.finalityDepth = 1
 	avalancheMainnet.gasEstimatorMode = "BlockHistory"
 	avalancheMainnet.gasPriceDefault = *assets.GWei(25)
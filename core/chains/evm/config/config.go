@@ -39,6 +39,7 @@ type ChainScopedOnlyConfig interface {
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
@@ -54,6 +55,7 @@ type ChainScopedOnlyConfig interface {
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
+	EvmLogPollInterval() time.Duration
 	EvmMaxGasPriceWei() *big.Int
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
@@ -481,6 +483,19 @@ func (c *chainScopedConfig) EvmFinalityDepth() uint32 {
 	return c.defaultSet.finalityDepth
 }
 
+// EvmFinalityTagEnabled derives finality from the chain's "finalized" block
+// tag instead of only EvmFinalityDepth. Blocks are considered final once the
+// chain reports them as finalized, falling back to EvmFinalityDepth if the
+// chain does not support the tag.
+func (c *chainScopedConfig) EvmFinalityTagEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmFinalityTagEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmFinalityTagEnabled", val)
+		return val
+	}
+	return c.defaultSet.finalityTagEnabled
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
@@ -953,6 +968,17 @@ func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return c.defaultSet.logBackfillBatchSize
 }
 
+// EvmLogPollInterval is how often the log poller polls for new blocks. It
+// defaults to roughly the block time of the chain.
+func (c *chainScopedConfig) EvmLogPollInterval() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmLogPollInterval()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogPollInterval", val)
+		return val
+	}
+	return c.defaultSet.logPollInterval
+}
+
 // EvmRPCDefaultBatchSize controls the number of receipts fetched in each
 // request in the EthConfirmer
 func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
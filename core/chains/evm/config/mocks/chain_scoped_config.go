@@ -759,6 +759,20 @@ func (_m *ChainScopedConfig) EVMEnabled() bool {
 	return r0
 }
 
+// EVMLogListenerOverflowPolicy provides a mock function with given fields:
+func (_m *ChainScopedConfig) EVMLogListenerOverflowPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EVMRPCEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) EVMRPCEnabled() bool {
 	ret := _m.Called()
@@ -889,6 +903,20 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -1109,6 +1137,20 @@ func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
+// EvmLogPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmMaxGasPriceWei() *big.Int {
 	ret := _m.Called()
@@ -1297,6 +1339,20 @@ func (_m *ChainScopedConfig) FeatureFeedsManager() bool {
 	return r0
 }
 
+// FeatureLogPoller provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeatureLogPoller() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FeatureOffchainReporting provides a mock function with given fields:
 func (_m *ChainScopedConfig) FeatureOffchainReporting() bool {
 	ret := _m.Called()
@@ -1689,6 +1745,27 @@ func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
@@ -2014,6 +2091,27 @@ func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogPollInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
@@ -2426,6 +2524,34 @@ func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperExportDir provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperExportDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// JobPipelineReaperFailedThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperFailedThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -2440,6 +2566,20 @@ func (_m *ChainScopedConfig) JobPipelineReaperInterval() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperMaxRuns provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperMaxRuns() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperThreshold provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineReaperThreshold() time.Duration {
 	ret := _m.Called()
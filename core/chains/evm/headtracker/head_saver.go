@@ -67,6 +67,10 @@ func (hs *headSaver) Chain(hash common.Hash) *evmtypes.Head {
 	return hs.heads.HeadByHash(hash)
 }
 
+func (hs *headSaver) MarkFinalized(finalized int64) {
+	hs.heads.MarkFinalized(finalized)
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -76,3 +80,4 @@ func (*nullSaver) LoadFromDB(ctx context.Context) (*evmtypes.Head, error)
 func (*nullSaver) LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error) { return nil, nil }
 func (*nullSaver) LatestChain() *evmtypes.Head                                  { return nil }
 func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head                        { return nil }
+func (*nullSaver) MarkFinalized(finalized int64)                                {}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,11 +31,39 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The highest block number known to be finalized",
+	}, []string{"evmChainID"})
+
+	promReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_reorgs",
+		Help: "Counter is incremented every time a re-org is detected",
+	}, []string{"evmChainID"})
+
+	promReorgedBlocks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_reorged_blocks",
+		Help: "Total number of blocks replaced by re-orgs",
+	}, []string{"evmChainID"})
+
+	promLastReorgDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_last_reorg_depth",
+		Help: "The number of blocks replaced by the most recent re-org",
+	}, []string{"evmChainID"})
+
+	promNodeLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_node_lag",
+		Help: "The latest head number reported by the node minus the highest head number seen by the head tracker. A positive value means the head tracker is behind the node",
+	}, []string{"evmChainID", "nodeName"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
 const HeadsBufferSize = 10
 
+// nodeLagCheckInterval controls how often the latest head of each node is compared with the highest seen head
+var nodeLagCheckInterval = 30 * time.Second
+
 type headTracker struct {
 	log             logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
@@ -50,6 +79,15 @@ type headTracker struct {
 	cancel       context.CancelFunc
 	chStop       chan struct{}
 	wgDone       sync.WaitGroup
+	// finalized is the highest block number known to be finalized, accessed atomically
+	finalized int64
+	// longestChain is the latest chain checked for re-orgs, only accessed by
+	// backfillLoop once started
+	longestChain *evmtypes.Head
+
+	reorgCallbacks      map[int]httypes.ReorgTrackable
+	reorgCallbacksMu    sync.Mutex
+	lastReorgCallbackID int
 	utils.StartStopOnce
 }
 
@@ -77,6 +115,7 @@ func NewHeadTracker(
 		chStop:          chStop,
 		headListener:    NewHeadListener(lggr, ethClient, config, chStop),
 		headSaver:       headSaver,
+		reorgCallbacks:  make(map[int]httypes.ReorgTrackable),
 	}
 }
 
@@ -99,6 +138,7 @@ func (ht *headTracker) Start() error {
 				"blockHash", latestChain.Hash,
 			)
 		}
+		ht.longestChain = latestChain
 
 		// NOTE: Always try to start the head tracker off with whatever the
 		// latest head is, without waiting for the subscription to send us one.
@@ -118,10 +158,11 @@ func (ht *headTracker) Start() error {
 			ht.log.Debug("Got nil initial head")
 		}
 
-		ht.wgDone.Add(3)
+		ht.wgDone.Add(4)
 		go ht.headListener.ListenForNewHeads(ht.handleNewHead, ht.wgDone.Done)
 		go ht.backfillLoop()
 		go ht.broadcastLoop()
+		go ht.nodeLagLoop()
 
 		return nil
 	})
@@ -160,6 +201,26 @@ func (ht *headTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Hea
 	return ht.backfill(ctx, headWithChain.EarliestInChain(), baseHeight)
 }
 
+func (ht *headTracker) LatestChain() *evmtypes.Head {
+	return ht.headSaver.LatestChain()
+}
+
+// SubscribeToReorgs calls OnReorg on the callback for every re-org detected,
+// until the HeadTracker is closed or unsubscribe is called
+func (ht *headTracker) SubscribeToReorgs(callback httypes.ReorgTrackable) (unsubscribe func()) {
+	ht.reorgCallbacksMu.Lock()
+	defer ht.reorgCallbacksMu.Unlock()
+
+	ht.lastReorgCallbackID++
+	callbackID := ht.lastReorgCallbackID
+	ht.reorgCallbacks[callbackID] = callback
+	return func() {
+		ht.reorgCallbacksMu.Lock()
+		defer ht.reorgCallbacksMu.Unlock()
+		delete(ht.reorgCallbacks, callbackID)
+	}
+}
+
 func (ht *headTracker) getInitialHead(ctx context.Context) (*evmtypes.Head, error) {
 	head, err := ht.ethClient.HeadByNumber(ctx, nil)
 	if err != nil {
@@ -192,6 +253,8 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	if prevHead == nil || head.Number > prevHead.Number {
 		promCurrentHead.WithLabelValues(ht.chainID.String()).Set(float64(head.Number))
 
+		ht.markFinalized(ctx, head)
+
 		headWithChain := ht.headSaver.Chain(head.Hash)
 		if headWithChain == nil {
 			return errors.Errorf("HeadTracker#handleNewHighestHead headWithChain was unexpectedly nil")
@@ -214,6 +277,50 @@ func (ht *headTracker) handleNewHead(ctx context.Context, head *evmtypes.Head) e
 	return nil
 }
 
+// markFinalized determines the highest finalized block for the given head
+// and flags the saved heads accordingly. When ETH_FINALITY_TAG_ENABLED is set
+// the node is asked for its "finalized" block, falling back to
+// ETH_FINALITY_DEPTH if that request fails.
+func (ht *headTracker) markFinalized(ctx context.Context, head *evmtypes.Head) {
+	// a head with ETH_FINALITY_DEPTH confirmations is considered final
+	finalized := head.Number - int64(ht.config.EvmFinalityDepth()) + 1
+	if ht.config.EvmFinalityTagEnabled() {
+		finalizedHead, err := ht.ethClient.HeadByNumber(ctx, big.NewInt(evmclient.FinalizedBlockNumber))
+		if ctx.Err() != nil {
+			return
+		} else if err != nil || finalizedHead == nil {
+			ht.log.Warnw("Failed to fetch finalized head, falling back to ETH_FINALITY_DEPTH", "err", err, "blockNumber", head.Number)
+		} else {
+			finalized = finalizedHead.Number
+		}
+	}
+	if finalized <= atomic.LoadInt64(&ht.finalized) {
+		return
+	}
+	atomic.StoreInt64(&ht.finalized, finalized)
+	promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(finalized))
+	ht.headSaver.MarkFinalized(finalized)
+}
+
+// backfillDepth returns how many heads should be kept in the chain ending at
+// the given head; at least ETH_FINALITY_DEPTH, extended to reach the latest
+// finalized block as far as ETH_HEAD_TRACKER_HISTORY_DEPTH allows.
+func (ht *headTracker) backfillDepth(head *evmtypes.Head) uint {
+	depth := int64(ht.config.EvmFinalityDepth())
+	finalized := atomic.LoadInt64(&ht.finalized)
+	if finalized <= 0 {
+		return uint(depth)
+	}
+	toFinalized := head.Number - finalized + 1
+	if historyDepth := int64(ht.config.EvmHeadTrackerHistoryDepth()); toFinalized > historyDepth {
+		toFinalized = historyDepth
+	}
+	if toFinalized > depth {
+		depth = toFinalized
+	}
+	return uint(depth)
+}
+
 func (ht *headTracker) broadcastLoop() {
 	defer ht.wgDone.Done()
 
@@ -268,18 +375,140 @@ func (ht *headTracker) backfillLoop() {
 				}
 				head := evmtypes.AsHead(item)
 				{
-					err := ht.Backfill(ht.ctx, head, uint(ht.config.EvmFinalityDepth()))
+					err := ht.Backfill(ht.ctx, head, ht.backfillDepth(head))
 					if err != nil {
 						ht.log.Warnw("Unexpected error while backfilling heads", "err", err)
 					} else if ht.ctx.Err() != nil {
 						break
 					}
 				}
+				ht.checkForReorg(head)
 			}
 		}
 	}
 }
 
+func (ht *headTracker) nodeLagLoop() {
+	defer ht.wgDone.Done()
+
+	ticker := time.NewTicker(nodeLagCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ht.chStop:
+			return
+		case <-ticker.C:
+			ht.checkNodeLag()
+		}
+	}
+}
+
+// checkNodeLag compares the latest head reported by each node with the
+// highest head seen
+func (ht *headTracker) checkNodeLag() {
+	latest := ht.headSaver.LatestChain()
+	if latest == nil {
+		return
+	}
+	ctx, cancel := utils.ContextFromChanWithDeadline(ht.chStop, nodeLagCheckInterval)
+	defer cancel()
+	for _, nh := range ht.ethClient.NodeHeads(ctx) {
+		if nh.Err != nil {
+			ht.log.Warnw("Failed to fetch latest head of node", "nodeName", nh.Name, "err", nh.Err)
+			continue
+		}
+		lag := nh.Number - latest.Number
+		promNodeLag.WithLabelValues(ht.chainID.String(), nh.Name).Set(float64(lag))
+		if lag > int64(ht.config.EvmFinalityDepth()) {
+			ht.log.Warnw(fmt.Sprintf("Highest seen head is %d blocks behind node %s", lag, nh.Name), "nodeName", nh.Name, "nodeHeadNum", nh.Number, "blockNumber", latest.Number)
+		}
+	}
+}
+
+// checkForReorg compares the backfilled chain of the given head with the
+// previous longest chain, and reports a re-org if the previous head is not
+// part of the new chain.
+func (ht *headTracker) checkForReorg(head *evmtypes.Head) {
+	// refetch the chain since backfill may have added parents
+	newChain := ht.headSaver.Chain(head.Hash)
+	if newChain == nil {
+		return
+	}
+	oldChain := ht.longestChain
+	ht.longestChain = newChain
+
+	if oldChain == nil || newChain.Number <= oldChain.Number {
+		return
+	}
+	if oldChain.Number < newChain.EarliestInChain().Number {
+		// the chains do not overlap, so there is nothing to compare
+		return
+	}
+	if newChain.IsInChain(oldChain.Hash) {
+		return
+	}
+
+	reorg := findReorg(oldChain, newChain)
+	promReorgs.WithLabelValues(ht.chainID.String()).Inc()
+	promReorgedBlocks.WithLabelValues(ht.chainID.String()).Add(float64(reorg.Depth))
+	promLastReorgDepth.WithLabelValues(ht.chainID.String()).Set(float64(reorg.Depth))
+	loggerFields := []interface{}{
+		"depth", reorg.Depth,
+		"fromBlock", reorg.FromBlock,
+		"toBlock", reorg.ToBlock,
+		"oldHeadNum", oldChain.Number,
+		"oldHeadHash", oldChain.Hash,
+		"newHeadNum", newChain.Number,
+		"newHeadHash", newChain.Hash,
+	}
+	if reorg.CommonAncestor != nil {
+		loggerFields = append(loggerFields, "commonAncestorNum", reorg.CommonAncestor.Number, "commonAncestorHash", reorg.CommonAncestor.Hash)
+	}
+	ht.log.Warnw(fmt.Sprintf("Re-org detected, %d block(s) replaced", reorg.Depth), loggerFields...)
+
+	ht.reorgCallbacksMu.Lock()
+	callbacks := make([]httypes.ReorgTrackable, 0, len(ht.reorgCallbacks))
+	for _, callback := range ht.reorgCallbacks {
+		callbacks = append(callbacks, callback)
+	}
+	ht.reorgCallbacksMu.Unlock()
+
+	var wg sync.WaitGroup
+	wg.Add(len(callbacks))
+	for _, callback := range callbacks {
+		go func(trackable httypes.ReorgTrackable) {
+			defer wg.Done()
+			ctx, cancel := utils.ContextFromChanWithDeadline(ht.chStop, TrackableCallbackTimeout)
+			defer cancel()
+			trackable.OnReorg(ctx, reorg)
+		}(callback)
+	}
+	wg.Wait()
+}
+
+// findReorg returns the re-org replacing oldChain with newChain
+func findReorg(oldChain, newChain *evmtypes.Head) httypes.Reorg {
+	reorg := httypes.Reorg{
+		OldHead: oldChain,
+		NewHead: newChain,
+		ToBlock: oldChain.Number,
+	}
+	for h := oldChain; h != nil; h = h.Parent {
+		if newChain.IsInChain(h.Hash) {
+			reorg.CommonAncestor = h
+			break
+		}
+	}
+	if reorg.CommonAncestor != nil {
+		reorg.FromBlock = reorg.CommonAncestor.Number + 1
+	} else {
+		// the common ancestor is older than the known heads, so this is a lower bound
+		reorg.FromBlock = oldChain.EarliestInChain().Number
+	}
+	reorg.Depth = reorg.ToBlock - reorg.FromBlock + 1
+	return reorg
+}
+
 // backfill fetches all missing heads up until the base height
 func (ht *headTracker) backfill(ctx context.Context, head *evmtypes.Head, baseHeight int64) (err error) {
 	if head.Number <= baseHeight {
@@ -349,3 +578,7 @@ func (*nullTracker) SetLogLevel(zapcore.Level) {}
 func (*nullTracker) Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error) {
 	return nil
 }
+func (*nullTracker) LatestChain() *evmtypes.Head { return nil }
+func (*nullTracker) SubscribeToReorgs(callback httypes.ReorgTrackable) (unsubscribe func()) {
+	return func() {}
+}
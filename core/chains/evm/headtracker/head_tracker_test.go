@@ -22,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
 	htmocks "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/mocks"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
@@ -341,6 +342,128 @@ func TestHeadTracker_Start_LoadsLatestChain(t *testing.T) {
 	assert.Equal(t, h.Number, int64(3))
 }
 
+func TestHeadTracker_MarksFinalizedHeads(t *testing.T) {
+	t.Parallel()
+
+	heads := []*evmtypes.Head{
+		cltest.Head(0),
+		cltest.Head(1),
+		cltest.Head(2),
+		cltest.Head(3),
+	}
+	for i := 1; i < len(heads); i++ {
+		heads[i].ParentHash = heads[i-1].Hash
+	}
+
+	newHeadTracker := func(t *testing.T, ethClient evmclient.Client, cfg *configtest.TestGeneralConfig) *headTrackerUniverse {
+		db := pgtest.NewSqlxDB(t)
+		orm := headtracker.NewORM(db, logger.TestLogger(t), cfg, cltest.FixtureChainID)
+		for _, h := range heads[:3] {
+			require.NoError(t, orm.IdempotentInsertHead(context.Background(), h))
+		}
+		return createHeadTracker(t, ethClient, evmtest.NewChainScopedConfig(t, cfg), orm)
+	}
+	newEthClient := func(t *testing.T) *evmmocks.Client {
+		ethClient, sub := cltest.NewEthClientAndSubMockWithDefaultChain(t)
+		ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(sub, nil)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(heads[3], nil)
+		sub.On("Unsubscribe").Maybe().Return()
+		sub.On("Err").Return(nil)
+		return ethClient
+	}
+
+	t.Run("uses the finalized block tag", func(t *testing.T) {
+		cfg := cltest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalEvmFinalityTagEnabled = null.BoolFrom(true)
+		ethClient := newEthClient(t)
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(evmclient.FinalizedBlockNumber)).Return(heads[1], nil)
+
+		ht := newHeadTracker(t, ethClient, cfg)
+		ht.Start(t)
+
+		finalized := ht.headSaver.LatestChain().LatestFinalizedHead()
+		require.NotNil(t, finalized)
+		assert.Equal(t, heads[1].Hash, finalized.Hash)
+		assert.False(t, ht.headSaver.Chain(heads[2].Hash).IsFinalized)
+	})
+
+	t.Run("falls back to ETH_FINALITY_DEPTH if the finalized head can't be fetched", func(t *testing.T) {
+		cfg := cltest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalEvmFinalityTagEnabled = null.BoolFrom(true)
+		cfg.Overrides.GlobalEvmFinalityDepth = null.IntFrom(2)
+		ethClient := newEthClient(t)
+		ethClient.On("HeadByNumber", mock.Anything, big.NewInt(evmclient.FinalizedBlockNumber)).Return(nil, errors.New("finalized tag not supported"))
+
+		ht := newHeadTracker(t, ethClient, cfg)
+		ht.Start(t)
+
+		finalized := ht.headSaver.LatestChain().LatestFinalizedHead()
+		require.NotNil(t, finalized)
+		assert.Equal(t, heads[2].Hash, finalized.Hash)
+	})
+}
+
+func TestHeadTracker_ReportsReorgs(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	logger := logger.TestLogger(t)
+	config := newCfg(t)
+	ethClient, sub := cltest.NewEthClientAndSubMockWithDefaultChain(t)
+	ethClient.On("SubscribeNewHead", mock.Anything, mock.Anything).Return(sub, nil)
+	sub.On("Unsubscribe").Maybe().Return()
+	sub.On("Err").Return(nil)
+
+	// old chain: 0 <- 1 <- 2a, new chain: 0 <- 1 <- 2b <- 3b
+	heads := []*evmtypes.Head{
+		cltest.Head(0),
+		cltest.Head(1),
+		cltest.Head(2),
+	}
+	for i := 1; i < len(heads); i++ {
+		heads[i].ParentHash = heads[i-1].Hash
+	}
+	head2b := cltest.Head(2)
+	head2b.ParentHash = heads[1].Hash
+	head3b := cltest.Head(3)
+	head3b.ParentHash = head2b.Hash
+
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(head3b, nil)
+	ethClient.On("HeadByNumber", mock.Anything, big.NewInt(2)).Return(head2b, nil)
+
+	orm := headtracker.NewORM(db, logger, config, cltest.FixtureChainID)
+	for _, h := range heads {
+		require.NoError(t, orm.IdempotentInsertHead(context.Background(), h))
+	}
+	ht := createHeadTracker(t, ethClient, config, orm)
+
+	chReorg := make(chan httypes.Reorg, 1)
+	trackable := new(htmocks.ReorgTrackable)
+	trackable.Test(t)
+	trackable.On("OnReorg", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		chReorg <- args.Get(1).(httypes.Reorg)
+	}).Once()
+	unsubscribe := ht.headTracker.SubscribeToReorgs(trackable)
+	defer unsubscribe()
+
+	ht.Start(t)
+
+	var reorg httypes.Reorg
+	select {
+	case reorg = <-chReorg:
+	case <-time.After(cltest.WaitTimeout(t)):
+		t.Fatal("timed out waiting for re-org")
+	}
+	assert.Equal(t, heads[2].Hash, reorg.OldHead.Hash)
+	assert.Equal(t, head3b.Hash, reorg.NewHead.Hash)
+	require.NotNil(t, reorg.CommonAncestor)
+	assert.Equal(t, heads[1].Hash, reorg.CommonAncestor.Hash)
+	assert.Equal(t, int64(1), reorg.Depth)
+	assert.Equal(t, int64(2), reorg.FromBlock)
+	assert.Equal(t, int64(2), reorg.ToBlock)
+	trackable.AssertExpectations(t)
+}
+
 func TestHeadTracker_SwitchesToLongestChainWithHeadSamplingEnabled(t *testing.T) {
 	// Need separate db because ht.Stop() will cancel the ctx, causing a db connection
 	// close and go-txdb rollback.
@@ -20,11 +20,15 @@ type Heads interface {
 	AddHeads(historyDepth uint, newHeads ...*evmtypes.Head)
 	// Count returns number of heads in the collection.
 	Count() int
+	// MarkFinalized flags every head at or below the given block number as
+	// finalized. Heads added afterwards are flagged using the same number.
+	MarkFinalized(finalized int64)
 }
 
 type heads struct {
-	heads []*evmtypes.Head
-	mu    sync.RWMutex
+	heads     []*evmtypes.Head
+	finalized int64
+	mu        sync.RWMutex
 }
 
 func NewHeads() Heads {
@@ -60,10 +64,26 @@ func (h *heads) Count() int {
 	return len(h.heads)
 }
 
+func (h *heads) MarkFinalized(finalized int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if finalized <= h.finalized {
+		return
+	}
+	h.finalized = finalized
+	// rebuild the collection so that chains already handed out are left untouched
+	h.addHeads(uint(len(h.heads)))
+}
+
 func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	h.addHeads(historyDepth, newHeads...)
+}
+
+func (h *heads) addHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 	headsMap := make(map[common.Hash]*evmtypes.Head, len(h.heads)+len(newHeads))
 	for _, head := range append(h.heads, newHeads...) {
 		if head.Hash == head.ParentHash {
@@ -74,6 +94,7 @@ func (h *heads) AddHeads(historyDepth uint, newHeads ...*evmtypes.Head) {
 		// elsewhere (since we mutate Parent here)
 		headCopy := *head
 		headCopy.Parent = nil // always build it from scratch in case it points to a head too old to be included
+		headCopy.IsFinalized = h.finalized > 0 && headCopy.Number <= h.finalized
 		// map eliminates duplicates
 		headsMap[head.Hash] = &headCopy
 	}
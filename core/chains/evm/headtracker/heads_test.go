@@ -107,3 +107,40 @@ func TestHeads_AddHeads(t *testing.T) {
 	require.NotNil(t, head)
 	require.Equal(t, 2, int(head.ChainLength()))
 }
+
+func TestHeads_MarkFinalized(t *testing.T) {
+	t.Parallel()
+
+	heads := headtracker.NewHeads()
+
+	var testHeads []*evmtypes.Head
+	var parentHash common.Hash
+	for i := 0; i < 5; i++ {
+		hash := utils.NewHash()
+		h := evmtypes.NewHead(big.NewInt(int64(i+1)), hash, parentHash, uint64(time.Now().Unix()), utils.NewBigI(0))
+		testHeads = append(testHeads, &h)
+		parentHash = hash
+	}
+
+	heads.AddHeads(5, testHeads[:4]...)
+	latest := heads.LatestHead()
+	require.Nil(t, latest.LatestFinalizedHead())
+
+	heads.MarkFinalized(2)
+	// chains handed out before are not mutated
+	require.Nil(t, latest.LatestFinalizedHead())
+
+	finalized := heads.LatestHead().LatestFinalizedHead()
+	require.NotNil(t, finalized)
+	require.Equal(t, int64(2), finalized.Number)
+	require.False(t, heads.HeadByHash(testHeads[2].Hash).IsFinalized)
+
+	// a lower block number is ignored
+	heads.MarkFinalized(1)
+	require.Equal(t, int64(2), heads.LatestHead().LatestFinalizedHead().Number)
+
+	// heads added later are flagged using the stored block number
+	heads.AddHeads(5, testHeads[4])
+	require.Equal(t, int64(5), heads.LatestHead().Number)
+	require.Equal(t, int64(2), heads.LatestHead().LatestFinalizedHead().Number)
+}
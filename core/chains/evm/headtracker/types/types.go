@@ -23,10 +23,13 @@ type HeadSaver interface {
 	LatestChain() *evmtypes.Head
 	// Chain returns a head for the specified hash, or nil.
 	Chain(hash common.Hash) *evmtypes.Head
+	// MarkFinalized flags all known heads at or below the given block number as finalized.
+	MarkFinalized(finalized int64)
 }
 
 // HeadTracker holds and stores the latest block number experienced by this particular node in a thread safe manner.
 // Reconstitutes the last block number from the data store on reboot.
+//go:generate mockery --name HeadTracker --output ../mocks/ --case=underscore
 type HeadTracker interface {
 	services.Service
 	// SetLogLevel changes log level for HeadTracker logger
@@ -34,6 +37,34 @@ type HeadTracker interface {
 	// Backfill given a head will fill in any missing heads up to the given depth
 	// (used for testing)
 	Backfill(ctx context.Context, headWithChain *evmtypes.Head, depth uint) (err error)
+	// LatestChain returns the highest head seen, with its parents up to
+	// EvmHeadTrackerHistoryDepth, or nil
+	LatestChain() *evmtypes.Head
+	// SubscribeToReorgs calls the callback for every re-org detected until
+	// the HeadTracker is closed, or unsubscribe is called
+	SubscribeToReorgs(callback ReorgTrackable) (unsubscribe func())
+}
+
+// Reorg describes a re-org detected by the HeadTracker, where the chain ending
+// at OldHead was replaced by the chain ending at NewHead.
+type Reorg struct {
+	OldHead *evmtypes.Head
+	NewHead *evmtypes.Head
+	// CommonAncestor is the highest head in both chains, or nil if it is
+	// older than the heads kept by the HeadTracker
+	CommonAncestor *evmtypes.Head
+	// Depth is the number of blocks of the old chain that were replaced
+	Depth int64
+	// FromBlock and ToBlock are the (inclusive) range of replaced block numbers
+	FromBlock int64
+	ToBlock   int64
+}
+
+// ReorgTrackable represents any object that wishes to be notified of re-orgs
+// detected by the HeadTracker
+//go:generate mockery --name ReorgTrackable --output ../mocks/ --case=underscore
+type ReorgTrackable interface {
+	OnReorg(ctx context.Context, reorg Reorg)
 }
 
 // HeadTrackable represents any object that wishes to respond to ethereum events,
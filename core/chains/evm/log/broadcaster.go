@@ -15,6 +15,7 @@ import (
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
@@ -49,9 +50,17 @@ type (
 		services.Service
 		httypes.HeadTrackable
 		ReplayFromBlock(number int64)
+		// ReplayListeners re-delivers the logs from a block number to the
+		// listeners of a job and/or on a contract only, instead of every
+		// listener on the chain.
+		ReplayListeners(opts ReplayListenersOpts) error
 
 		IsConnected() bool
 		Register(listener Listener, opts ListenerOpts) (unsubscribe func())
+		// NewListenerQueue returns a queue for a listener of jobID to buffer
+		// its logs in, which applies EVM_LOG_LISTENER_OVERFLOW_POLICY when it
+		// holds more than capacity logs.
+		NewListenerQueue(name string, jobID int32, capacity uint64, parseLog ParseLogFunc) *ListenerQueue
 
 		WasAlreadyConsumed(lb Broadcast, qopts ...pg.QOpt) (bool, error)
 		MarkConsumed(lb Broadcast, qopts ...pg.QOpt) error
@@ -101,8 +110,10 @@ type (
 		wgDone                sync.WaitGroup
 		trackedAddressesCount atomic.Uint32
 		replayChannel         chan int64
+		replayListenersCh     chan ReplayListenersOpts
 		highestSavedHead      *evmtypes.Head
 		lastSeenHeadNumber    atomic.Int64
+		lastFinalizedNumber   atomic.Int64
 		logger                logger.Logger
 
 		// used for testing only
@@ -114,6 +125,7 @@ type (
 		BlockBackfillSkip() bool
 		EvmFinalityDepth() uint32
 		EvmLogBackfillBatchSize() uint32
+		EVMLogListenerOverflowPolicy() string
 	}
 
 	ListenerOpts struct {
@@ -129,6 +141,25 @@ type (
 
 		// Minimum number of block confirmations before the log is received
 		MinIncomingConfirmations uint32
+
+		// FinalizedOnly delays the log until its block is finalized, in
+		// addition to MinIncomingConfirmations
+		FinalizedOnly bool
+	}
+
+	// ReplayListenersOpts scopes a replay to the listeners of a job and/or on
+	// a contract. At least one of JobID and Contract must be set.
+	ReplayListenersOpts struct {
+		// FromBlock is the block number to replay logs from
+		FromBlock int64
+		// JobID, if non-zero, only replays logs to the listeners of this job
+		JobID int32
+		// Contract, if non-zero, only replays logs to the listeners on this contract
+		Contract common.Address
+		// ForceBroadcast also re-delivers logs that were already consumed.
+		// WasAlreadyConsumed returns false for them, so that listeners
+		// process them again.
+		ForceBroadcast bool
 	}
 
 	ParseLogFunc func(log types.Log) (generated.AbigenLog, error)
@@ -146,6 +177,10 @@ const (
 	subscriberStatusUnsubscribe
 )
 
+// maxPendingReplayListeners bounds the number of ReplayListeners requests
+// waiting for the event loop.
+const maxPendingReplayListeners = 10
+
 var _ Broadcaster = (*broadcaster)(nil)
 
 // NewBroadcaster creates a new instance of the broadcaster
@@ -166,6 +201,7 @@ func NewBroadcaster(orm ORM, ethClient evmclient.Client, config Config, lggr log
 		chStop:                 chStop,
 		highestSavedHead:       highestSavedHead,
 		replayChannel:          make(chan int64, 1),
+		replayListenersCh:      make(chan ReplayListenersOpts, maxPendingReplayListeners),
 	}
 }
 
@@ -185,6 +221,22 @@ func (b *broadcaster) ReplayFromBlock(number int64) {
 	}
 }
 
+func (b *broadcaster) ReplayListeners(opts ReplayListenersOpts) error {
+	if opts.JobID == 0 && opts.Contract == (common.Address{}) {
+		return errors.New("a job ID or contract address is required to replay logs to listeners")
+	}
+	if opts.FromBlock < 0 {
+		return errors.Errorf("block number cannot be negative: %v", opts.FromBlock)
+	}
+	b.logger.Infow("Replay requested for listeners", "fromBlock", opts.FromBlock, "jobID", opts.JobID, "contract", opts.Contract, "force", opts.ForceBroadcast)
+	select {
+	case b.replayListenersCh <- opts:
+		return nil
+	default:
+		return errors.New("too many replays are pending, try again later")
+	}
+}
+
 func (b *broadcaster) Close() error {
 	return b.StopOnce("LogBroadcaster", func() error {
 		close(b.chStop)
@@ -255,6 +307,15 @@ func (b *broadcaster) Register(listener Listener, opts ListenerOpts) (unsubscrib
 	return
 }
 
+func (b *broadcaster) NewListenerQueue(name string, jobID int32, capacity uint64, parseLog ParseLogFunc) *ListenerQueue {
+	policy, err := ParseOverflowPolicy(b.config.EVMLogListenerOverflowPolicy())
+	if err != nil {
+		b.logger.Errorw("Invalid listener queue overflow policy, dropping the oldest logs instead", "err", err)
+		policy = OverflowPolicyDropOldest
+	}
+	return NewListenerQueue(name, jobID, b.evmChainID, capacity, policy, parseLog, b.orm, b.logger)
+}
+
 func (b *broadcaster) OnNewLongestChain(ctx context.Context, head *evmtypes.Head) {
 	wasOverCapacity := b.newHeads.Deliver(head)
 	if wasOverCapacity {
@@ -424,6 +485,9 @@ func (b *broadcaster) eventLoop(chRawLogs <-chan types.Log, chErr <-chan error)
 			b.logger.Debugw("Returning from the event loop to replay logs from specific block number", "blockNumber", blockNumber)
 			return true, nil
 
+		case opts := <-b.replayListenersCh:
+			b.onReplayListeners(opts)
+
 		case <-debounceResubscribe.C:
 			if needsResubscribe {
 				b.logger.Debug("Returning from the event loop to resubscribe")
@@ -485,6 +549,10 @@ func (b *broadcaster) onNewHeads() {
 			"blockHash", latestHead.Hash, "parentHash", latestHead.ParentHash, "chainLen", latestHead.ChainLength())
 
 		b.lastSeenHeadNumber.Store(latestHead.Number)
+		finalizedHead := latestHead.LatestFinalizedHead()
+		if finalizedHead != nil {
+			b.lastFinalizedNumber.Store(finalizedHead.Number)
+		}
 
 		keptLogsDepth := uint32(b.config.EvmFinalityDepth())
 		if b.registrations.highestNumConfirmations > keptLogsDepth {
@@ -493,6 +561,10 @@ func (b *broadcaster) onNewHeads() {
 
 		latestBlockNum := latestHead.Number
 		keptDepth := latestBlockNum - int64(keptLogsDepth)
+		if b.registrations.finalizedOnlySubs > 0 && finalizedHead != nil && finalizedHead.Number < keptDepth {
+			// keep logs until they are finalized, so they can still be sent to finalized-only listeners
+			keptDepth = finalizedHead.Number
+		}
 		if keptDepth < 0 {
 			keptDepth = 0
 		}
@@ -535,6 +607,114 @@ func (b *broadcaster) onNewHeads() {
 	}
 }
 
+// onReplayListeners replays logs to the subscribers matching opts in the
+// background, without resubscribing or going through the log pool, so that
+// other listeners are not affected.
+func (b *broadcaster) onReplayListeners(opts ReplayListenersOpts) {
+	lggr := b.logger.With("fromBlock", opts.FromBlock, "jobID", opts.JobID, "contract", opts.Contract, "force", opts.ForceBroadcast)
+	subs := b.registrations.subscribersMatching(opts.JobID, opts.Contract)
+	if len(subs) == 0 {
+		lggr.Warn("No listeners match the replay request, nothing to replay")
+		return
+	}
+	lggr.Infow("Replaying logs to listeners", "listeners", len(subs))
+	b.wgDone.Add(1)
+	go func() {
+		defer b.wgDone.Done()
+		b.replayToSubscribers(lggr, opts, subs)
+	}()
+}
+
+func (b *broadcaster) replayToSubscribers(lggr logger.Logger, opts ReplayListenersOpts, subs []*subscriber) {
+	seenAddresses := make(map[common.Address]struct{})
+	seenTopics := make(map[common.Hash]struct{})
+	var addresses []common.Address
+	var topics []common.Hash
+	for _, sub := range subs {
+		if _, exists := seenAddresses[sub.opts.Contract]; !exists {
+			seenAddresses[sub.opts.Contract] = struct{}{}
+			addresses = append(addresses, sub.opts.Contract)
+		}
+		for topic := range sub.opts.LogsWithTopics {
+			if _, exists := seenTopics[topic]; !exists {
+				seenTopics[topic] = struct{}{}
+				topics = append(topics, topic)
+			}
+		}
+	}
+
+	chLogs, abort := b.ethSubscriber.backfillLogs(null.Int64From(opts.FromBlock), addresses, topics)
+	if abort || chLogs == nil {
+		return
+	}
+
+	ctx, cancel := utils.ContextFromChan(b.chStop)
+	defer cancel()
+
+	// Logs are only replayed once they have enough confirmations for the
+	// listener, relative to the latest head seen
+	latestBlockNumber := uint64(b.lastSeenHeadNumber.Load())
+	var sent int
+	for log := range chLogs {
+		for _, sub := range subs {
+			if b.replayLog(ctx, lggr, log, sub, latestBlockNumber, opts.ForceBroadcast) {
+				sent++
+			}
+		}
+	}
+	lggr.Infow("Finished replaying logs to listeners", "sent", sent, "latestBlockNumber", latestBlockNumber)
+}
+
+// replayLog sends the log to the subscriber if it matches its filters, and
+// unless it was already consumed and force is false. It returns true if the
+// log was sent.
+func (b *broadcaster) replayLog(ctx context.Context, lggr logger.Logger, log types.Log, sub *subscriber, latestBlockNumber uint64, force bool) bool {
+	if log.Removed || len(log.Topics) == 0 || log.Address != sub.opts.Contract {
+		return false
+	}
+	filters, exists := sub.opts.LogsWithTopics[log.Topics[0]]
+	if !exists {
+		return false
+	}
+	if len(filters) > 0 && len(log.Topics) > 1 && !filtersContainValues(log.Topics[1:], filters) {
+		return false
+	}
+	if log.BlockNumber+uint64(sub.opts.MinIncomingConfirmations)-1 > latestBlockNumber {
+		return false
+	}
+	if sub.opts.FinalizedOnly && int64(log.BlockNumber) > b.lastFinalizedNumber.Load() {
+		return false
+	}
+
+	jobID := sub.listener.JobID()
+	if !force {
+		consumed, err := b.orm.WasBroadcastConsumed(log.BlockHash, log.Index, jobID, pg.WithParentCtx(ctx))
+		if err != nil {
+			lggr.Errorw("Could not check whether the log was consumed", "blockNumber", log.BlockNumber, "blockHash", log.BlockHash, "err", err)
+			return false
+		} else if consumed {
+			return false
+		}
+	}
+
+	logCopy := gethwrappers.DeepCopyLog(log)
+	decodedLog, err := sub.opts.ParseLog(logCopy)
+	if err != nil {
+		lggr.Errorw("Could not parse contract log", "err", err)
+		return false
+	}
+	lggr.Debugw("Replaying log", "blockNumber", log.BlockNumber, "blockHash", log.BlockHash, "address", log.Address, "jobID", jobID)
+	sub.listener.HandleLog(&broadcast{
+		latestBlockNumber: latestBlockNumber,
+		decodedLog:        decodedLog,
+		rawLog:            logCopy,
+		jobID:             jobID,
+		evmChainID:        b.evmChainID,
+		forced:            force,
+	})
+	return true
+}
+
 func (b *broadcaster) onChangeSubscriberStatus() (needsResubscribe bool) {
 	for {
 		x, exists := b.changeSubscriberStatus.Retrieve()
@@ -610,8 +790,12 @@ func (b *broadcaster) maybeWarnOnLargeBlockNumberDifference(logBlockNumber int64
 	}
 }
 
-// WasAlreadyConsumed reports whether the given consumer had already consumed the given log
+// WasAlreadyConsumed reports whether the given consumer had already consumed the given log.
+// It returns false for logs replayed with ReplayListenersOpts.ForceBroadcast.
 func (b *broadcaster) WasAlreadyConsumed(lb Broadcast, qopts ...pg.QOpt) (bool, error) {
+	if bc, ok := lb.(*broadcast); ok && bc.forced {
+		return false, nil
+	}
 	return b.orm.WasBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().Index, lb.JobID(), qopts...)
 }
 
@@ -660,7 +844,14 @@ func (n *NullBroadcaster) Register(listener Listener, opts ListenerOpts) (unsubs
 	return func() {}
 }
 
+func (n *NullBroadcaster) NewListenerQueue(name string, jobID int32, capacity uint64, parseLog ParseLogFunc) *ListenerQueue {
+	return NewListenerQueue(name, jobID, big.Int{}, capacity, OverflowPolicyDropOldest, parseLog, nil, logger.NullLogger)
+}
+
 func (n *NullBroadcaster) ReplayFromBlock(number int64) {}
+func (n *NullBroadcaster) ReplayListeners(opts ReplayListenersOpts) error {
+	return errors.New(n.ErrMsg)
+}
 
 func (n *NullBroadcaster) BackfillBlockNumber() null.Int64 {
 	return null.NewInt64(0, false)
@@ -189,6 +189,75 @@ func TestBroadcaster_BackfillOnNodeStartAndOnReplay(t *testing.T) {
 	helper.mockEth.assertExpectations(t)
 }
 
+func TestBroadcaster_ReplayListeners(t *testing.T) {
+	const blockHeight int64 = 10
+	blocks := cltest.NewBlocks(t, int(blockHeight+1))
+	contract1 := newMockContract()
+	contract2 := newMockContract()
+	log1 := blocks.LogOnBlockNum(1, contract1.Address())
+	log2 := blocks.LogOnBlockNum(2, contract2.Address())
+	contract1.On("ParseLog", log1).Return(flux_aggregator_wrapper.FluxAggregatorNewRound{}, nil)
+	contract2.On("ParseLog", log2).Return(flux_aggregator_wrapper.FluxAggregatorNewRound{}, nil)
+
+	// One initial backfill, then one per replay
+	chchRawLogs := make(chan chan<- types.Log, 1)
+	mockEth := newMockEthClient(t, chchRawLogs, blockHeight, mockEthClientExpectedCalls{
+		SubscribeFilterLogs: 1,
+		HeaderByNumber:      3,
+		FilterLogs:          3,
+		FilterLogsResult:    []types.Log{log1, log2},
+	})
+	var filterLogsCount atomic.Int64
+	mockEth.checkFilterLogs = func(int64, int64) { filterLogsCount.Inc() }
+	helper := newBroadcasterHelperWithEthClient(t, mockEth.ethClient, nil)
+	helper.chchRawLogs = chchRawLogs
+	helper.mockEth = mockEth
+
+	listener1 := helper.newLogListenerWithJob("one")
+	listener2 := helper.newLogListenerWithJob("two")
+	helper.lb.AddDependents(1)
+	helper.start()
+	defer helper.stop()
+	helper.register(listener1, contract1, 1)
+	helper.register(listener2, contract2, 1)
+	defer helper.unsubscribeAll()
+	helper.lb.DependentReady()
+
+	<-cltest.SimulateIncomingHeads(t, cltest.SimulateIncomingHeadsArgs{
+		StartBlock:     1,
+		EndBlock:       blockHeight,
+		Blocks:         blocks,
+		HeadTrackables: []httypes.HeadTrackable{(helper.lb).(httypes.HeadTrackable)},
+	})
+	require.Eventually(t, func() bool {
+		return len(listener1.received.getLogs()) == 1 && len(listener2.received.getLogs()) == 1
+	}, cltest.WaitTimeout(t), 100*time.Millisecond)
+
+	require.Error(t, helper.lb.ReplayListeners(log.ReplayListenersOpts{FromBlock: 0}))
+
+	// Consumed logs are not replayed unless forced
+	require.NoError(t, helper.lb.ReplayListeners(log.ReplayListenersOpts{FromBlock: 0, JobID: listener1.JobID()}))
+	require.Eventually(t, func() bool { return filterLogsCount.Load() == 2 }, cltest.WaitTimeout(t), 100*time.Millisecond)
+	require.Never(t, func() bool { return len(listener1.received.getLogs()) > 1 }, time.Second, 100*time.Millisecond)
+
+	require.NoError(t, helper.lb.ReplayListeners(log.ReplayListenersOpts{FromBlock: 0, Contract: contract1.Address(), ForceBroadcast: true}))
+	require.Eventually(t, func() bool { return len(listener1.received.getLogs()) == 2 }, cltest.WaitTimeout(t), 100*time.Millisecond)
+	assert.Len(t, listener2.received.getLogs(), 1)
+
+	listener1.received.Lock()
+	broadcasts := listener1.received.broadcasts
+	listener1.received.Unlock()
+	assert.Equal(t, log1, broadcasts[1].RawLog())
+	consumed, err := helper.lb.WasAlreadyConsumed(broadcasts[0])
+	require.NoError(t, err)
+	assert.True(t, consumed)
+	consumed, err = helper.lb.WasAlreadyConsumed(broadcasts[1])
+	require.NoError(t, err)
+	assert.False(t, consumed)
+
+	helper.mockEth.assertExpectations(t)
+}
+
 func TestBroadcaster_BackfillUnconsumedAfterCrash(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	lggr := logger.TestLogger(t)
@@ -0,0 +1,273 @@
+package log
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var (
+	promListenerQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_listener_queue_depth",
+		Help: "The number of logs waiting in memory in a listener queue",
+	}, []string{"evmChainID", "jobID", "queue"})
+
+	promListenerQueueDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_listener_queue_dropped_logs",
+		Help: "Counter is incremented every time a listener queue drops a log because it is full",
+	}, []string{"evmChainID", "jobID", "queue"})
+
+	promListenerQueueSpilled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_listener_queue_spilled_logs",
+		Help: "Counter is incremented every time a listener queue saves a log to the database because it is full",
+	}, []string{"evmChainID", "jobID", "queue"})
+
+	promListenerQueueLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "log_listener_queue_latency_seconds",
+		Help:    "How long logs wait in a listener queue before being processed",
+		Buckets: []float64{0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900},
+	}, []string{"evmChainID", "jobID", "queue"})
+)
+
+// OverflowPolicy is what a ListenerQueue does with a log when it is full.
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock blocks the delivery until there is room in the
+	// queue. Since logs are delivered by the log broadcaster, this slows down
+	// the broadcasts to every listener on the chain.
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// OverflowPolicyDropOldest drops the oldest log in the queue.
+	OverflowPolicyDropOldest OverflowPolicy = "drop-oldest"
+	// OverflowPolicySpill saves the log to the database, and loads it back
+	// once the queue is empty.
+	OverflowPolicySpill OverflowPolicy = "spill"
+)
+
+// ParseOverflowPolicy returns the OverflowPolicy named s.
+func ParseOverflowPolicy(s string) (OverflowPolicy, error) {
+	switch p := OverflowPolicy(s); p {
+	case OverflowPolicyBlock, OverflowPolicyDropOldest, OverflowPolicySpill:
+		return p, nil
+	default:
+		return "", errors.Errorf("unknown overflow policy: %q", s)
+	}
+}
+
+type queuedBroadcast struct {
+	lb          Broadcast
+	deliveredAt time.Time
+}
+
+// ListenerQueue buffers the logs delivered to a listener until they are
+// processed, in order. It is a replacement for utils.Mailbox which reports
+// its depth, the logs it drops and how long logs wait in it, and which
+// applies an OverflowPolicy when it is full.
+//
+// Listeners get a queue from Broadcaster.NewListenerQueue, Deliver to it in
+// HandleLog, and Retrieve from it after each Notify.
+type ListenerQueue struct {
+	name       string
+	jobID      int32
+	evmChainID big.Int
+	capacity   uint64
+	policy     OverflowPolicy
+	parseLog   ParseLogFunc
+	orm        ORM
+	lggr       logger.Logger
+
+	chNotify chan struct{}
+	chRoom   chan struct{}
+	chStop   chan struct{}
+	stopOnce sync.Once
+
+	mu    sync.Mutex
+	queue []queuedBroadcast
+	// spilled is set while there may be logs of this queue in the database.
+	// Logs spilled before a restart are not loaded back, because they are
+	// unconsumed and are therefore broadcast again by the backfill.
+	spilled bool
+
+	depth    prometheus.Gauge
+	dropped  prometheus.Counter
+	spills   prometheus.Counter
+	latency  prometheus.Observer
+	labelVal []string
+}
+
+// NewListenerQueue creates a queue of the given capacity for a listener of
+// jobID, or an unbounded queue if the capacity is zero. The name tells apart the queues of the same job in logs and metrics.
+// The orm and parseLog are only used by OverflowPolicySpill.
+func NewListenerQueue(name string, jobID int32, evmChainID big.Int, capacity uint64, policy OverflowPolicy, parseLog ParseLogFunc, orm ORM, lggr logger.Logger) *ListenerQueue {
+	labelVal := []string{evmChainID.String(), fmt.Sprintf("%d", jobID), name}
+	return &ListenerQueue{
+		name:       name,
+		jobID:      jobID,
+		evmChainID: evmChainID,
+		capacity:   capacity,
+		policy:     policy,
+		parseLog:   parseLog,
+		orm:        orm,
+		lggr:       lggr.Named("ListenerQueue").With("queue", name, "jobID", jobID),
+		chNotify:   make(chan struct{}, 1),
+		chRoom:     make(chan struct{}, 1),
+		chStop:     make(chan struct{}),
+		depth:      promListenerQueueDepth.WithLabelValues(labelVal...),
+		dropped:    promListenerQueueDropped.WithLabelValues(labelVal...),
+		spills:     promListenerQueueSpilled.WithLabelValues(labelVal...),
+		latency:    promListenerQueueLatency.WithLabelValues(labelVal...),
+		labelVal:   labelVal,
+	}
+}
+
+// Notify receives a value whenever logs are delivered to the queue
+func (q *ListenerQueue) Notify() <-chan struct{} {
+	return q.chNotify
+}
+
+// Len returns the number of logs waiting in memory in the queue
+func (q *ListenerQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.queue)
+}
+
+// Deliver appends a log to the queue, applying the overflow policy if the
+// queue is full.
+func (q *ListenerQueue) Deliver(lb Broadcast) {
+	for {
+		q.mu.Lock()
+		if !q.spilled && (q.capacity == 0 || uint64(len(q.queue)) < q.capacity) {
+			q.push(lb, time.Now())
+			q.mu.Unlock()
+			q.notify()
+			return
+		}
+
+		switch q.policy {
+		case OverflowPolicyBlock:
+			q.mu.Unlock()
+			select {
+			case <-q.chRoom:
+				continue
+			case <-q.chStop:
+				q.dropped.Inc()
+				q.lggr.Warnw("Queue was closed while waiting for room - dropped the log", "log", lb.String())
+				return
+			}
+		case OverflowPolicySpill:
+			err := q.orm.SpillBroadcast(q.name, lb)
+			if err == nil {
+				q.spilled = true
+				q.spills.Inc()
+				q.mu.Unlock()
+				q.notify()
+				return
+			}
+			q.lggr.Errorw("Failed to spill log to the database", "err", err, "log", lb.String())
+		}
+
+		q.pushDroppingOldest(lb)
+		q.mu.Unlock()
+		q.notify()
+		return
+	}
+}
+
+// Retrieve removes the oldest log from the queue. When the queue runs out of
+// logs in memory, it loads back the logs spilled to the database.
+func (q *ListenerQueue) Retrieve() (Broadcast, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.queue) == 0 && q.spilled {
+		if err := q.unspill(); err != nil {
+			q.lggr.Errorw("Failed to load spilled logs from the database", "err", err)
+			break
+		}
+	}
+	if len(q.queue) == 0 {
+		return nil, false
+	}
+
+	next := q.queue[0]
+	q.queue = q.queue[1:]
+	q.depth.Set(float64(len(q.queue)))
+	q.latency.Observe(time.Since(next.deliveredAt).Seconds())
+
+	select {
+	case q.chRoom <- struct{}{}:
+	default:
+	}
+	return next.lb, true
+}
+
+// Close stops the deliveries blocked on a full queue and removes the queue
+// from the metrics.
+func (q *ListenerQueue) Close() {
+	q.stopOnce.Do(func() {
+		close(q.chStop)
+		promListenerQueueDepth.DeleteLabelValues(q.labelVal...)
+	})
+}
+
+func (q *ListenerQueue) push(lb Broadcast, deliveredAt time.Time) {
+	q.queue = append(q.queue, queuedBroadcast{lb, deliveredAt})
+	q.depth.Set(float64(len(q.queue)))
+}
+
+// pushDroppingOldest appends lb to the logs in memory, dropping the oldest
+// one if they are over capacity. It must be called with the lock held.
+func (q *ListenerQueue) pushDroppingOldest(lb Broadcast) {
+	if q.capacity > 0 && uint64(len(q.queue)) >= q.capacity {
+		q.lggr.Errorw("Queue is over capacity - dropped the oldest log", "capacity", q.capacity, "log", q.queue[0].lb.String())
+		q.dropped.Inc()
+		q.queue = q.queue[1:]
+	}
+	q.push(lb, time.Now())
+}
+
+func (q *ListenerQueue) notify() {
+	select {
+	case q.chNotify <- struct{}{}:
+	default:
+	}
+}
+
+// unspill loads up to capacity spilled logs back into memory. It must be
+// called with the lock held.
+func (q *ListenerQueue) unspill() error {
+	spilled, err := q.orm.UnspillBroadcasts(q.name, q.jobID, int(q.capacity))
+	if err != nil {
+		return err
+	}
+	if len(spilled) == 0 {
+		q.spilled = false
+		return nil
+	}
+	for _, s := range spilled {
+		decodedLog, err := q.parseLog(s.RawLog)
+		if err != nil {
+			// The log is still unconsumed, so it is broadcast again on restart
+			q.lggr.Errorw("Could not parse spilled log", "err", err, "blockNumber", s.RawLog.BlockNumber, "blockHash", s.RawLog.BlockHash)
+			continue
+		}
+		q.push(&broadcast{
+			latestBlockNumber: s.LatestBlockNumber,
+			latestBlockHash:   s.LatestBlockHash,
+			decodedLog:        decodedLog,
+			rawLog:            s.RawLog,
+			jobID:             q.jobID,
+			evmChainID:        q.evmChainID,
+			forced:            s.Forced,
+		}, s.CreatedAt)
+	}
+	return nil
+}
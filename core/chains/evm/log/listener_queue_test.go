@@ -0,0 +1,155 @@
+package log_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func parseNewRound(l types.Log) (generated.AbigenLog, error) {
+	return &flux_aggregator_wrapper.FluxAggregatorNewRound{Raw: l}, nil
+}
+
+func newQueuedBroadcast(blockNumber uint64) log.Broadcast {
+	rawLog := types.Log{BlockNumber: blockNumber, BlockHash: common.BigToHash(big.NewInt(int64(blockNumber)))}
+	return log.NewLogBroadcast(rawLog, *big.NewInt(42), &flux_aggregator_wrapper.FluxAggregatorNewRound{Raw: rawLog})
+}
+
+func retrieveBlockNumbers(t *testing.T, q *log.ListenerQueue) (blockNumbers []uint64) {
+	t.Helper()
+	for {
+		lb, ok := q.Retrieve()
+		if !ok {
+			return
+		}
+		blockNumbers = append(blockNumbers, lb.RawLog().BlockNumber)
+	}
+}
+
+func TestListenerQueue_DropOldest(t *testing.T) {
+	q := log.NewListenerQueue("test", 1, *big.NewInt(42), 2, log.OverflowPolicyDropOldest, parseNewRound, nil, logger.TestLogger(t))
+	defer q.Close()
+
+	for i := uint64(1); i <= 3; i++ {
+		q.Deliver(newQueuedBroadcast(i))
+	}
+
+	select {
+	case <-q.Notify():
+	default:
+		t.Fatal("expected a notification")
+	}
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, []uint64{2, 3}, retrieveBlockNumbers(t, q))
+}
+
+func TestListenerQueue_Block(t *testing.T) {
+	q := log.NewListenerQueue("test", 1, *big.NewInt(42), 1, log.OverflowPolicyBlock, parseNewRound, nil, logger.TestLogger(t))
+	defer q.Close()
+
+	q.Deliver(newQueuedBroadcast(1))
+
+	delivered := make(chan struct{})
+	go func() {
+		q.Deliver(newQueuedBroadcast(2))
+		close(delivered)
+	}()
+
+	select {
+	case <-delivered:
+		t.Fatal("expected the delivery to block while the queue is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	lb, ok := q.Retrieve()
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), lb.RawLog().BlockNumber)
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the delivery to complete once there was room")
+	}
+	assert.Equal(t, []uint64{2}, retrieveBlockNumbers(t, q))
+
+	t.Run("closing the queue unblocks deliveries", func(t *testing.T) {
+		q.Deliver(newQueuedBroadcast(3))
+
+		delivered := make(chan struct{})
+		go func() {
+			q.Deliver(newQueuedBroadcast(4))
+			close(delivered)
+		}()
+		q.Close()
+
+		select {
+		case <-delivered:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the delivery to return once the queue was closed")
+		}
+		assert.Equal(t, []uint64{3}, retrieveBlockNumbers(t, q))
+	})
+}
+
+func TestListenerQueue_Spill(t *testing.T) {
+	orm := new(logmocks.ORM)
+	orm.Test(t)
+	q := log.NewListenerQueue("test", 1, *big.NewInt(42), 1, log.OverflowPolicySpill, parseNewRound, orm, logger.TestLogger(t))
+	defer q.Close()
+
+	var spilled []log.SpilledBroadcast
+	orm.On("SpillBroadcast", "test", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		lb := args.Get(1).(log.Broadcast)
+		spilled = append(spilled, log.SpilledBroadcast{ID: int64(len(spilled) + 1), RawLog: lb.RawLog(), CreatedAt: time.Now()})
+	})
+
+	q.Deliver(newQueuedBroadcast(1))
+	q.Deliver(newQueuedBroadcast(2))
+	// Logs keep being spilled while there are spilled logs in the database,
+	// even if there is room in memory, so that they stay in order
+	lb, ok := q.Retrieve()
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), lb.RawLog().BlockNumber)
+	q.Deliver(newQueuedBroadcast(3))
+
+	require.Len(t, spilled, 2)
+	assert.Equal(t, 0, q.Len())
+
+	orm.ExpectedCalls = nil
+	orm.On("UnspillBroadcasts", "test", int32(1), 1).Return(spilled[:1], nil).Once()
+	orm.On("UnspillBroadcasts", "test", int32(1), 1).Return(spilled[1:], nil).Once()
+	orm.On("UnspillBroadcasts", "test", int32(1), 1).Return(nil, nil).Once()
+
+	lb, ok = q.Retrieve()
+	require.True(t, ok)
+	assert.Equal(t, uint64(2), lb.RawLog().BlockNumber)
+	chainID := lb.EVMChainID()
+	assert.Equal(t, "42", chainID.String())
+	assert.IsType(t, &flux_aggregator_wrapper.FluxAggregatorNewRound{}, lb.DecodedLog())
+
+	assert.Equal(t, []uint64{3}, retrieveBlockNumbers(t, q))
+	orm.AssertExpectations(t)
+
+	t.Run("drops the oldest log if it cannot be spilled", func(t *testing.T) {
+		orm.On("SpillBroadcast", "test", mock.Anything).Return(errors.New("no database")).Once()
+
+		q.Deliver(newQueuedBroadcast(4))
+		q.Deliver(newQueuedBroadcast(5))
+
+		assert.Equal(t, []uint64{5}, retrieveBlockNumbers(t, q))
+		orm.AssertExpectations(t)
+	})
+}
@@ -107,6 +107,22 @@ func (_m *Broadcaster) MarkConsumed(lb log.Broadcast, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// NewListenerQueue provides a mock function with given fields: name, jobID, capacity, parseLog
+func (_m *Broadcaster) NewListenerQueue(name string, jobID int32, capacity uint64, parseLog log.ParseLogFunc) *log.ListenerQueue {
+	ret := _m.Called(name, jobID, capacity, parseLog)
+
+	var r0 *log.ListenerQueue
+	if rf, ok := ret.Get(0).(func(string, int32, uint64, log.ParseLogFunc) *log.ListenerQueue); ok {
+		r0 = rf(name, jobID, capacity, parseLog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*log.ListenerQueue)
+		}
+	}
+
+	return r0
+}
+
 // OnNewLongestChain provides a mock function with given fields: ctx, head
 func (_m *Broadcaster) OnNewLongestChain(ctx context.Context, head *types.Head) {
 	_m.Called(ctx, head)
@@ -147,6 +163,20 @@ func (_m *Broadcaster) ReplayFromBlock(number int64) {
 	_m.Called(number)
 }
 
+// ReplayListeners provides a mock function with given fields: opts
+func (_m *Broadcaster) ReplayListeners(opts log.ReplayListenersOpts) error {
+	ret := _m.Called(opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(log.ReplayListenersOpts) error); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Broadcaster) Start() error {
 	ret := _m.Called()
@@ -37,6 +37,20 @@ func (_m *Config) BlockBackfillSkip() bool {
 	return r0
 }
 
+// EVMLogListenerOverflowPolicy provides a mock function with given fields:
+func (_m *Config) EVMLogListenerOverflowPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmFinalityDepth provides a mock function with given fields:
 func (_m *Config) EvmFinalityDepth() uint32 {
 	ret := _m.Called()
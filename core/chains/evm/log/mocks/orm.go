@@ -159,6 +159,57 @@ func (_m *ORM) SetPendingMinBlock(blockNum *int64, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// SpillBroadcast provides a mock function with given fields: queue, lb, qopts
+func (_m *ORM) SpillBroadcast(queue string, lb log.Broadcast, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, queue, lb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, log.Broadcast, ...pg.QOpt) error); ok {
+		r0 = rf(queue, lb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnspillBroadcasts provides a mock function with given fields: queue, jobID, limit, qopts
+func (_m *ORM) UnspillBroadcasts(queue string, jobID int32, limit int, qopts ...pg.QOpt) ([]log.SpilledBroadcast, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, queue, jobID, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []log.SpilledBroadcast
+	if rf, ok := ret.Get(0).(func(string, int32, int, ...pg.QOpt) []log.SpilledBroadcast); ok {
+		r0 = rf(queue, jobID, limit, qopts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.SpilledBroadcast)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int32, int, ...pg.QOpt) error); ok {
+		r1 = rf(queue, jobID, limit, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WasBroadcastConsumed provides a mock function with given fields: blockHash, logIndex, jobID, qopts
 func (_m *ORM) WasBroadcastConsumed(blockHash common.Hash, logIndex uint, jobID int32, qopts ...pg.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
@@ -31,6 +31,9 @@ type (
 		rawLog            types.Log
 		jobID             int32
 		evmChainID        big.Int
+		// forced is set on logs replayed with ReplayListenersOpts.ForceBroadcast,
+		// so that they are processed again even if they were already consumed
+		forced bool
 	}
 )
 
@@ -2,7 +2,10 @@ package log
 
 import (
 	"database/sql"
+	"encoding/json"
 	"math/big"
+	"sort"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -37,9 +40,15 @@ type ORM interface {
 	// GetPendingMinBlock returns the minimum block number for which there were pending broadcasts in the pool, or nil if it was empty.
 	GetPendingMinBlock(qopts ...pg.QOpt) (blockNumber *int64, err error)
 
-	// Reinitialize cleans up the database by removing any unconsumed broadcasts, then updating (if necessary) and
-	// returning the pending minimum block number.
+	// Reinitialize cleans up the database by removing any unconsumed and spilled broadcasts, then updating (if
+	// necessary) and returning the pending minimum block number.
 	Reinitialize(qopts ...pg.QOpt) (blockNumber *int64, err error)
+
+	// SpillBroadcast saves a broadcast that did not fit in the named listener queue.
+	SpillBroadcast(queue string, lb Broadcast, qopts ...pg.QOpt) error
+	// UnspillBroadcasts removes and returns up to limit of the oldest broadcasts spilled from the named listener
+	// queue of jobID.
+	UnspillBroadcasts(queue string, jobID int32, limit int, qopts ...pg.QOpt) ([]SpilledBroadcast, error)
 }
 
 type orm struct {
@@ -111,6 +120,10 @@ func (o *orm) MarkBroadcastConsumed(blockHash common.Hash, blockNumber uint64, l
 }
 
 func (o *orm) Reinitialize(qopts ...pg.QOpt) (*int64, error) {
+	// Spilled broadcasts are unconsumed, so they will be backfilled again.
+	if err := o.removeSpilled(qopts...); err != nil {
+		return nil, err
+	}
 	// Minimum block number from the set of unconsumed logs, which we'll remove later.
 	minUnconsumed, err := o.getUnconsumedMinBlock(qopts...)
 	if err != nil {
@@ -191,6 +204,73 @@ func (o *orm) removeUnconsumed(qopts ...pg.QOpt) error {
 	return errors.Wrap(err, "failed to delete unconsumed broadcasts")
 }
 
+func (o *orm) SpillBroadcast(queue string, lb Broadcast, qopts ...pg.QOpt) error {
+	rawLog, err := json.Marshal(lb.RawLog())
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal log")
+	}
+	var forced bool
+	if bc, ok := lb.(*broadcast); ok {
+		forced = bc.forced
+	}
+	q := o.q.WithOpts(qopts...)
+	err = q.ExecQ(`
+        INSERT INTO log_broadcasts_spilled (evm_chain_id, job_id, queue, raw_log, latest_block_number, latest_block_hash, forced, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+    `, o.evmChainID, lb.JobID(), queue, rawLog, lb.LatestBlockNumber(), lb.LatestBlockHash(), forced)
+	return errors.Wrap(err, "failed to spill log broadcast")
+}
+
+func (o *orm) UnspillBroadcasts(queue string, jobID int32, limit int, qopts ...pg.QOpt) ([]SpilledBroadcast, error) {
+	var rows []struct {
+		ID                int64
+		RawLog            []byte
+		LatestBlockNumber uint64
+		LatestBlockHash   common.Hash
+		Forced            bool
+		CreatedAt         time.Time
+	}
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&rows, `
+        DELETE FROM log_broadcasts_spilled
+		WHERE id IN (
+			SELECT id FROM log_broadcasts_spilled
+			WHERE evm_chain_id = $1
+			AND job_id = $2
+			AND queue = $3
+			ORDER BY id ASC
+			LIMIT $4
+		)
+		RETURNING id, raw_log, latest_block_number, latest_block_hash, forced, created_at
+    `, o.evmChainID, jobID, queue, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unspill log broadcasts")
+	}
+	// RETURNING does not preserve the order of the subquery
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID < rows[j].ID })
+
+	spilled := make([]SpilledBroadcast, len(rows))
+	for i, row := range rows {
+		spilled[i] = SpilledBroadcast{
+			ID:                row.ID,
+			LatestBlockNumber: row.LatestBlockNumber,
+			LatestBlockHash:   row.LatestBlockHash,
+			Forced:            row.Forced,
+			CreatedAt:         row.CreatedAt,
+		}
+		if err := json.Unmarshal(row.RawLog, &spilled[i].RawLog); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal spilled log %v", row.ID)
+		}
+	}
+	return spilled, nil
+}
+
+func (o *orm) removeSpilled(qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.ExecQ(`DELETE FROM log_broadcasts_spilled WHERE evm_chain_id = $1`, o.evmChainID)
+	return errors.Wrap(err, "failed to delete spilled broadcasts")
+}
+
 // LogBroadcast - data from log_broadcasts table columns
 type LogBroadcast struct {
 	BlockHash common.Hash
@@ -214,6 +294,16 @@ type LogBroadcastAsKey struct {
 	JobId     int32
 }
 
+// SpilledBroadcast - data from log_broadcasts_spilled table columns
+type SpilledBroadcast struct {
+	ID                int64
+	RawLog            types.Log
+	LatestBlockNumber uint64
+	LatestBlockHash   common.Hash
+	Forced            bool
+	CreatedAt         time.Time
+}
+
 func NewLogBroadcastAsKey(log types.Log, listener Listener) LogBroadcastAsKey {
 	return LogBroadcastAsKey{
 		log.BlockHash,
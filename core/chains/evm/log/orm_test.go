@@ -6,11 +6,13 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -193,3 +195,66 @@ func TestORM_Reinitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestORM_SpilledBroadcasts(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	orm := log.NewORM(db, lggr, cfg, cltest.FixtureChainID)
+
+	_, addr := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	specV2 := cltest.MustInsertV2JobSpec(t, db, addr)
+
+	var rawLogs []types.Log
+	for i := 0; i < 3; i++ {
+		rawLog := cltest.RandomLog(t)
+		rawLogs = append(rawLogs, rawLog)
+
+		lb := new(logmocks.Broadcast)
+		lb.On("RawLog").Return(rawLog)
+		lb.On("JobID").Return(specV2.ID)
+		lb.On("LatestBlockNumber").Return(rawLog.BlockNumber + 1)
+		lb.On("LatestBlockHash").Return(rawLog.BlockHash)
+		require.NoError(t, orm.SpillBroadcast("queue", lb))
+	}
+
+	spilled, err := orm.UnspillBroadcasts("other", specV2.ID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, spilled)
+
+	spilled, err = orm.UnspillBroadcasts("queue", specV2.ID, 2)
+	require.NoError(t, err)
+	require.Len(t, spilled, 2)
+	for i, s := range spilled {
+		assert.Equal(t, rawLogs[i], s.RawLog)
+		assert.Equal(t, rawLogs[i].BlockNumber+1, s.LatestBlockNumber)
+		assert.False(t, s.Forced)
+	}
+
+	spilled, err = orm.UnspillBroadcasts("queue", specV2.ID, 2)
+	require.NoError(t, err)
+	require.Len(t, spilled, 1)
+	assert.Equal(t, rawLogs[2], spilled[0].RawLog)
+
+	spilled, err = orm.UnspillBroadcasts("queue", specV2.ID, 2)
+	require.NoError(t, err)
+	assert.Empty(t, spilled)
+
+	t.Run("spilled broadcasts are removed on reinitialize", func(t *testing.T) {
+		lb := new(logmocks.Broadcast)
+		lb.On("RawLog").Return(rawLogs[0])
+		lb.On("JobID").Return(specV2.ID)
+		lb.On("LatestBlockNumber").Return(rawLogs[0].BlockNumber)
+		lb.On("LatestBlockHash").Return(rawLogs[0].BlockHash)
+		require.NoError(t, orm.SpillBroadcast("queue", lb))
+
+		_, err := orm.Reinitialize()
+		require.NoError(t, err)
+
+		spilled, err := orm.UnspillBroadcasts("queue", specV2.ID, 2)
+		require.NoError(t, err)
+		assert.Empty(t, spilled)
+	})
+}
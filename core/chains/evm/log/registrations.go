@@ -27,6 +27,7 @@ import (
 // 		Each stored log is checked against every matched listener and is sent unless:
 //    A) is too young for that listener
 //    B) matches a log already consumed (via the database information from log_broadcasts table)
+//    C) the listener is FinalizedOnly and the log's block is not finalized yet
 //
 // A log might be sent multiple times, if a consumer processes logs asynchronously (e.g. via a queue or a Mailbox), in which case the log
 // may not be marked as consumed before the next sending operation. That's why customers must still check the state via WasAlreadyConsumed
@@ -52,6 +53,9 @@ type (
 		// highest 'NumConfirmations' per all listeners, used to decide about deleting older logs if it's higher than EvmFinalityDepth
 		// it's: max(listeners.map(l => l.num_confirmations)
 		highestNumConfirmations uint32
+
+		// number of listeners with FinalizedOnly set, used to keep logs until they are finalized
+		finalizedOnlySubs int
 	}
 
 	handler struct {
@@ -101,6 +105,10 @@ func (r *registrations) addSubscriber(sub *subscriber) (needsResubscribe bool) {
 
 	needsResubscribe = handler.addSubscriber(sub, r.handlersWithGreaterConfs(sub.opts.MinIncomingConfirmations))
 
+	if sub.opts.FinalizedOnly {
+		r.finalizedOnlySubs++
+	}
+
 	// increase the variable for highest number of confirmations among all subscribers,
 	// if the new subscriber has a higher value
 	if sub.opts.MinIncomingConfirmations > r.highestNumConfirmations {
@@ -150,6 +158,10 @@ func (r *registrations) removeSubscriber(sub *subscriber) (needsResubscribe bool
 	}
 	r.logger.Tracef("Removed subscription %p with job ID %v", sub, sub.listener.JobID())
 
+	if sub.opts.FinalizedOnly {
+		r.finalizedOnlySubs--
+	}
+
 	handlers, exists := r.handlersByConfs[sub.opts.MinIncomingConfirmations]
 	if !exists {
 		return
@@ -212,6 +224,21 @@ func (r *registrations) addressesAndTopics() ([]common.Address, []common.Hash) {
 	return addresses, topics
 }
 
+// subscribersMatching returns the subscribers of the job and on the contract.
+// A zero jobID or contract matches any.
+func (r *registrations) subscribersMatching(jobID int32, contract common.Address) (subs []*subscriber) {
+	for sub := range r.registeredSubs {
+		if jobID != 0 && sub.listener.JobID() != jobID {
+			continue
+		}
+		if contract != (common.Address{}) && sub.opts.Contract != contract {
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	return
+}
+
 func (r *registrations) isAddressRegistered(address common.Address) bool {
 	for _, sub := range r.handlersByConfs {
 		if sub.isAddressRegistered(address) {
@@ -228,6 +255,10 @@ func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead evmtypes.H
 	}
 
 	latestBlockNumber := uint64(latestHead.Number)
+	finalizedBlockNumber := int64(-1)
+	if finalizedHead := latestHead.LatestFinalizedHead(); finalizedHead != nil {
+		finalizedBlockNumber = finalizedHead.Number
+	}
 
 	for _, logsPerBlock := range logsToSend {
 		for numConfirmations, handlers := range r.handlersByConfs {
@@ -245,7 +276,7 @@ func (r *registrations) sendLogs(logsToSend []logsOnBlock, latestHead evmtypes.H
 			}
 
 			for _, log := range logsPerBlock.Logs {
-				handlers.sendLog(log, latestHead, broadcastsExisting, bc, r.logger)
+				handlers.sendLog(log, latestHead, finalizedBlockNumber, broadcastsExisting, bc, r.logger)
 			}
 		}
 	}
@@ -392,6 +423,7 @@ type broadcastCreator interface {
 }
 
 func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
+	finalizedBlockNumber int64,
 	broadcasts map[LogBroadcastAsKey]bool,
 	bc broadcastCreator,
 	logger logger.Logger) {
@@ -401,6 +433,10 @@ func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 	latestBlockNumber := uint64(latestHead.Number)
 	var wg sync.WaitGroup
 	for sub, filters := range r.lookupSubs[log.Address][topic] {
+		if sub.opts.FinalizedOnly && int64(log.BlockNumber) > finalizedBlockNumber {
+			continue
+		}
+
 		currentBroadcast := NewLogBroadcastAsKey(log, sub.listener)
 		consumed, exists := broadcasts[currentBroadcast]
 		if exists && consumed {
@@ -451,6 +487,7 @@ func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 				logCopy,
 				jobID,
 				r.evmChainID,
+				false,
 			})
 		}()
 	}
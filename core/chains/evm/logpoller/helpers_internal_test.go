@@ -0,0 +1,21 @@
+package logpoller
+
+import (
+	"context"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// NewTestLogPoller creates a log poller whose polls can be run synchronously.
+func NewTestLogPoller(orm ORM, ec evmclient.Client, config Config, lggr logger.Logger) *logPoller {
+	return NewLogPoller(orm, ec, config, lggr).(*logPoller)
+}
+
+func (lp *logPoller) PollAndSaveLogs(ctx context.Context) {
+	lp.pollAndSaveLogs(ctx)
+}
+
+func (lp *logPoller) ReplaySync(ctx context.Context, fromBlock int64) error {
+	return lp.replay(ctx, fromBlock)
+}
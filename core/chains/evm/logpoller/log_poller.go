@@ -0,0 +1,386 @@
+package logpoller
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name LogPoller --output ./mocks/ --case=underscore --structname LogPoller --filename log_poller.go
+
+// LogPoller polls the chain for the logs matching the registered filters and
+// stores them in the database, so that they can be queried at any time
+// instead of being received once from a live subscription.
+//
+// Blocks that are already final are fetched in ranges with eth_getLogs. More
+// recent blocks are fetched one at a time by hash, and their hashes are kept
+// to detect reorgs: when a new block's parent hash does not match the stored
+// block, the stored blocks and logs are deleted back to the common ancestor
+// and fetched again.
+type LogPoller interface {
+	services.Service
+
+	// MergeFilter adds event signatures and addresses to the filter. Logs
+	// matching any of the event signatures, emitted by any of the addresses,
+	// are stored from the next poll onwards. Use Replay to fetch earlier logs.
+	// The filter is only kept in memory: it is empty when the node starts,
+	// and filters must be merged again on every start. Nothing is polled
+	// while the filter is empty.
+	MergeFilter(eventSigs []common.Hash, addresses []common.Address)
+	// Replay fetches the logs matching the filter again, from the given block
+	// up to the latest processed block.
+	Replay(ctx context.Context, fromBlock int64) error
+
+	// LatestBlock returns the number of the latest processed block.
+	LatestBlock(qopts ...pg.QOpt) (int64, error)
+	// Logs returns the logs with the event signature emitted by the address
+	// between the start and end blocks, inclusive.
+	Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error)
+	// LogsWithSigs returns the logs with any of the event signatures emitted
+	// by the address between the start and end blocks, inclusive.
+	LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error)
+	// LatestLogByEventSigWithConfs returns the most recent log with the event
+	// signature emitted by the address, in a block with at least confs blocks
+	// processed on top of it, or nil if there is none.
+	LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error)
+}
+
+// Config is the chain config used by the log poller.
+type Config interface {
+	EvmFinalityDepth() uint32
+	EvmLogBackfillBatchSize() uint32
+	EvmLogPollInterval() time.Duration
+}
+
+type replayRequest struct {
+	fromBlock int64
+	done      chan error
+}
+
+type logPoller struct {
+	utils.StartStopOnce
+	ec      evmclient.Client
+	orm     ORM
+	config  Config
+	lggr    logger.Logger
+	chainID *utils.Big
+
+	filterMu  sync.RWMutex
+	addresses map[common.Address]struct{}
+	eventSigs map[common.Hash]struct{}
+
+	chReplay chan replayRequest
+	chStop   chan struct{}
+	wgDone   sync.WaitGroup
+}
+
+var _ LogPoller = (*logPoller)(nil)
+
+// NewLogPoller creates a log poller storing the logs of the client's chain
+// in orm.
+func NewLogPoller(orm ORM, ec evmclient.Client, config Config, lggr logger.Logger) LogPoller {
+	return &logPoller{
+		ec:        ec,
+		orm:       orm,
+		config:    config,
+		lggr:      lggr.Named("LogPoller"),
+		chainID:   utils.NewBig(ec.ChainID()),
+		addresses: make(map[common.Address]struct{}),
+		eventSigs: make(map[common.Hash]struct{}),
+		chReplay:  make(chan replayRequest),
+		chStop:    make(chan struct{}),
+	}
+}
+
+// Start starts polling logs.
+func (lp *logPoller) Start() error {
+	return lp.StartOnce("LogPoller", func() error {
+		lp.wgDone.Add(1)
+		go lp.run()
+		return nil
+	})
+}
+
+// Close stops polling logs.
+func (lp *logPoller) Close() error {
+	return lp.StopOnce("LogPoller", func() error {
+		close(lp.chStop)
+		lp.wgDone.Wait()
+		return nil
+	})
+}
+
+func (lp *logPoller) MergeFilter(eventSigs []common.Hash, addresses []common.Address) {
+	lp.filterMu.Lock()
+	defer lp.filterMu.Unlock()
+	for _, sig := range eventSigs {
+		lp.eventSigs[sig] = struct{}{}
+	}
+	for _, addr := range addresses {
+		lp.addresses[addr] = struct{}{}
+	}
+}
+
+// filterQuery returns the query for the logs matching the filter, or false if
+// the filter is empty. Without an address or event signature, eth_getLogs
+// would return every log.
+func (lp *logPoller) filterQuery() (ethereum.FilterQuery, bool) {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	if len(lp.addresses) == 0 || len(lp.eventSigs) == 0 {
+		return ethereum.FilterQuery{}, false
+	}
+	addresses := make([]common.Address, 0, len(lp.addresses))
+	for addr := range lp.addresses {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+	eventSigs := make([]common.Hash, 0, len(lp.eventSigs))
+	for sig := range lp.eventSigs {
+		eventSigs = append(eventSigs, sig)
+	}
+	sort.Slice(eventSigs, func(i, j int) bool { return bytes.Compare(eventSigs[i][:], eventSigs[j][:]) < 0 })
+	return ethereum.FilterQuery{Addresses: addresses, Topics: [][]common.Hash{eventSigs}}, true
+}
+
+func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
+	req := replayRequest{fromBlock: fromBlock, done: make(chan error, 1)}
+	select {
+	case lp.chReplay <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-lp.chStop:
+		return errors.New("log poller is stopped")
+	}
+	select {
+	case err := <-req.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (lp *logPoller) run() {
+	defer lp.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(lp.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(lp.config.EvmLogPollInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-lp.chStop:
+			return
+		case req := <-lp.chReplay:
+			req.done <- lp.replay(ctx, req.fromBlock)
+		case <-ticker.C:
+			lp.pollAndSaveLogs(ctx)
+		}
+	}
+}
+
+func (lp *logPoller) replay(ctx context.Context, fromBlock int64) error {
+	latest, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		return err
+	}
+	if latest == nil {
+		return errors.New("cannot replay logs before the first poll")
+	}
+	if fromBlock < 0 || fromBlock > latest.BlockNumber {
+		return errors.Errorf("cannot replay logs from block %d, the latest processed block is %d", fromBlock, latest.BlockNumber)
+	}
+	lp.lggr.Infow("Replaying logs", "fromBlock", fromBlock, "toBlock", latest.BlockNumber)
+	return lp.backfill(ctx, fromBlock, latest.BlockNumber)
+}
+
+// backfill fetches and stores the logs matching the filter between the start
+// and end blocks, inclusive, in batches of block ranges.
+func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	query, ok := lp.filterQuery()
+	if !ok {
+		return nil
+	}
+	batchSize := int64(lp.config.EvmLogBackfillBatchSize())
+	for from := start; from <= end; from += batchSize {
+		to := from + batchSize - 1
+		if to > end {
+			to = end
+		}
+		query.FromBlock = big.NewInt(from)
+		query.ToBlock = big.NewInt(to)
+		gethLogs, err := lp.ec.FilterLogs(ctx, query)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get logs from block %d to %d", from, to)
+		}
+		if len(gethLogs) == 0 {
+			continue
+		}
+		lp.lggr.Debugw("Backfilled logs", "fromBlock", from, "toBlock", to, "logs", len(gethLogs))
+		if err = lp.orm.InsertLogs(convertLogs(lp.chainID, gethLogs), pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pollAndSaveLogs processes the blocks since the latest processed block.
+func (lp *logPoller) pollAndSaveLogs(ctx context.Context) {
+	// Without a filter there are no logs to store, so skip the RPC calls
+	if _, ok := lp.filterQuery(); !ok {
+		return
+	}
+	latest, err := lp.ec.HeadByNumber(ctx, nil)
+	if err != nil {
+		lp.lggr.Warnw("Failed to get latest block", "err", err)
+		return
+	}
+	last, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		lp.lggr.Errorw("Failed to get latest processed block", "err", err)
+		return
+	}
+
+	// Start from the latest block on the first poll: earlier logs can be
+	// fetched with Replay
+	start := latest.Number
+	if last != nil {
+		start = last.BlockNumber + 1
+	}
+	if start > latest.Number {
+		return
+	}
+
+	finalized := latest.Number - int64(lp.config.EvmFinalityDepth())
+	if start < finalized {
+		if err = lp.backfill(ctx, start, finalized); err != nil {
+			lp.lggr.Warnw("Failed to backfill finalized logs", "fromBlock", start, "toBlock", finalized, "err", err)
+			return
+		}
+		head, err2 := lp.ec.HeadByNumber(ctx, big.NewInt(finalized))
+		if err2 != nil {
+			lp.lggr.Warnw("Failed to get block", "blockNumber", finalized, "err", err2)
+			return
+		}
+		if err = lp.orm.InsertBlock(head.Hash, head.Number, pg.WithParentCtx(ctx)); err != nil {
+			lp.lggr.Errorw("Failed to save block", "blockNumber", finalized, "err", err)
+			return
+		}
+		start = finalized + 1
+	}
+
+	for n := start; n <= latest.Number; n++ {
+		head, err := lp.ec.HeadByNumber(ctx, big.NewInt(n))
+		if err != nil {
+			lp.lggr.Warnw("Failed to get block", "blockNumber", n, "err", err)
+			return
+		}
+
+		parent, err := lp.orm.SelectBlockByNumber(n-1, pg.WithParentCtx(ctx))
+		if err != nil {
+			lp.lggr.Errorw("Failed to get processed block", "blockNumber", n-1, "err", err)
+			return
+		}
+		if parent != nil && parent.BlockHash != head.ParentHash {
+			ancestor, err2 := lp.findCommonAncestor(ctx, n-1)
+			if err2 != nil {
+				lp.lggr.Warnw("Failed to find common ancestor of reorg", "blockNumber", n, "err", err2)
+				return
+			}
+			lp.lggr.Warnw("Reorg detected, removing logs of reorged blocks", "blockNumber", n, "commonAncestor", ancestor, "depth", n-1-ancestor)
+			if err2 = lp.orm.DeleteBlocksAfter(ancestor+1, pg.WithParentCtx(ctx)); err2 != nil {
+				lp.lggr.Errorw("Failed to remove reorged blocks", "err", err2)
+				return
+			}
+			// Continue from the block after the common ancestor
+			n = ancestor
+			continue
+		}
+
+		if err = lp.saveBlock(ctx, head.Hash, n); err != nil {
+			lp.lggr.Warnw("Failed to save logs of block", "blockNumber", n, "err", err)
+			return
+		}
+	}
+
+	// Only recent blocks are needed to detect reorgs
+	if err = lp.orm.DeleteBlocksBefore(finalized, pg.WithParentCtx(ctx)); err != nil {
+		lp.lggr.Errorw("Failed to delete old blocks", "err", err)
+	}
+}
+
+// saveBlock stores the logs of the block matching the filter, and the block.
+func (lp *logPoller) saveBlock(ctx context.Context, blockHash common.Hash, blockNumber int64) error {
+	query, ok := lp.filterQuery()
+	var logs []Log
+	if ok {
+		query.BlockHash = &blockHash
+		gethLogs, err := lp.ec.FilterLogs(ctx, query)
+		if err != nil {
+			return errors.Wrap(err, "failed to get logs")
+		}
+		logs = convertLogs(lp.chainID, gethLogs)
+	}
+	return lp.orm.InsertBlockWithLogs(blockHash, blockNumber, logs, pg.WithParentCtx(ctx))
+}
+
+// findCommonAncestor walks back from the given block number until the stored
+// block matches the chain.
+func (lp *logPoller) findCommonAncestor(ctx context.Context, blockNumber int64) (int64, error) {
+	for n := blockNumber; n >= 0; n-- {
+		stored, err := lp.orm.SelectBlockByNumber(n, pg.WithParentCtx(ctx))
+		if err != nil {
+			return 0, err
+		}
+		if stored == nil {
+			lp.lggr.Criticalw("Reorg is deeper than the finality depth", "blockNumber", n)
+			return n, nil
+		}
+		head, err := lp.ec.HeadByNumber(ctx, big.NewInt(n))
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get block %d", n)
+		}
+		if head.Hash == stored.BlockHash {
+			return n, nil
+		}
+	}
+	return 0, errors.New("no common ancestor found")
+}
+
+func (lp *logPoller) LatestBlock(qopts ...pg.QOpt) (int64, error) {
+	b, err := lp.orm.SelectLatestBlock(qopts...)
+	if err != nil {
+		return 0, err
+	}
+	if b == nil {
+		return 0, errors.New("no block processed yet")
+	}
+	return b.BlockNumber, nil
+}
+
+func (lp *logPoller) Logs(start, end int64, eventSig common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
+	return lp.orm.SelectLogsByBlockRange(start, end, address, eventSig, qopts...)
+}
+
+func (lp *logPoller) LogsWithSigs(start, end int64, eventSigs []common.Hash, address common.Address, qopts ...pg.QOpt) ([]Log, error) {
+	return lp.orm.SelectLogsWithSigsByBlockRange(start, end, address, eventSigs, qopts...)
+}
+
+func (lp *logPoller) LatestLogByEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error) {
+	return lp.orm.SelectLatestLogEventSigWithConfs(eventSig, address, confs, qopts...)
+}
@@ -0,0 +1,215 @@
+package logpoller_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type testConfig struct{}
+
+func (testConfig) EvmFinalityDepth() uint32          { return 2 }
+func (testConfig) EvmLogBackfillBatchSize() uint32   { return 3 }
+func (testConfig) EvmLogPollInterval() time.Duration { return time.Hour }
+
+// simulatedChain is a chain with one log per block, emitted by address with
+// eventSig, that can be extended and reorged.
+type simulatedChain struct {
+	mu       sync.Mutex
+	address  common.Address
+	eventSig common.Hash
+	blocks   []evmtypes.Head // blocks[n] is block n
+}
+
+func newSimulatedChain(address common.Address, eventSig common.Hash, latest int64) *simulatedChain {
+	c := &simulatedChain{address: address, eventSig: eventSig}
+	c.extendTo(latest)
+	return c
+}
+
+func (c *simulatedChain) extendTo(latest int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for n := int64(len(c.blocks)); n <= latest; n++ {
+		var parent common.Hash
+		if n > 0 {
+			parent = c.blocks[n-1].Hash
+		}
+		c.blocks = append(c.blocks, evmtypes.Head{Number: n, Hash: common.Hash(testutils.Random32Byte()), ParentHash: parent})
+	}
+}
+
+// reorg replaces the blocks from the given block number onwards.
+func (c *simulatedChain) reorg(from, latest int64) {
+	c.mu.Lock()
+	c.blocks = c.blocks[:from]
+	c.mu.Unlock()
+	c.extendTo(latest)
+}
+
+func (c *simulatedChain) log(h evmtypes.Head) types.Log {
+	return types.Log{
+		Address:     c.address,
+		Topics:      []common.Hash{c.eventSig},
+		Data:        h.Hash.Bytes(),
+		BlockNumber: uint64(h.Number),
+		BlockHash:   h.Hash,
+		TxHash:      common.Hash(testutils.Random32Byte()),
+	}
+}
+
+func (c *simulatedChain) headByNumber(_ context.Context, n *big.Int) *evmtypes.Head {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if n == nil {
+		h := c.blocks[len(c.blocks)-1]
+		return &h
+	}
+	h := c.blocks[n.Int64()]
+	return &h
+}
+
+func (c *simulatedChain) filterLogs(_ context.Context, q ethereum.FilterQuery) []types.Log {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var logs []types.Log
+	for _, h := range c.blocks {
+		if q.BlockHash != nil {
+			if h.Hash != *q.BlockHash {
+				continue
+			}
+		} else if h.Number < q.FromBlock.Int64() || h.Number > q.ToBlock.Int64() {
+			continue
+		}
+		logs = append(logs, c.log(h))
+	}
+	return logs
+}
+
+func (c *simulatedChain) hash(n int64) common.Hash {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blocks[n].Hash
+}
+
+func newClient(t *testing.T, c *simulatedChain) *evmmocks.Client {
+	ec := new(evmmocks.Client)
+	ec.Test(t)
+	t.Cleanup(func() { ec.AssertExpectations(t) })
+	ec.On("ChainID").Return(testutils.FixtureChainID)
+	ec.On("HeadByNumber", mock.Anything, mock.Anything).Return(c.headByNumber, nil)
+	ec.On("FilterLogs", mock.Anything, mock.Anything).Return(c.filterLogs, nil)
+	return ec
+}
+
+func assertLogs(t *testing.T, lp logpoller.LogPoller, c *simulatedChain, start, end int64) {
+	t.Helper()
+	logs, err := lp.Logs(0, 100, c.eventSig, c.address)
+	require.NoError(t, err)
+	require.Len(t, logs, int(end-start+1))
+	for i, l := range logs {
+		n := start + int64(i)
+		assert.Equal(t, n, l.BlockNumber)
+		assert.Equal(t, c.hash(n), l.BlockHash)
+		assert.Equal(t, c.hash(n).Bytes(), l.Data)
+	}
+}
+
+func TestLogPoller_PollAndSaveLogs(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	orm := logpoller.NewORM(db, lggr, pgtest.NewPGCfg(true), *testutils.FixtureChainID)
+
+	address := testutils.NewAddress()
+	eventSig := common.HexToHash("0x1234")
+	c := newSimulatedChain(address, eventSig, 5)
+	lp := logpoller.NewTestLogPoller(orm, newClient(t, c), testConfig{}, lggr)
+	lp.MergeFilter([]common.Hash{eventSig}, []common.Address{address})
+	ctx := testutils.Context(t)
+
+	// The first poll starts at the latest block
+	lp.PollAndSaveLogs(ctx)
+	latest, err := lp.LatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), latest)
+	assertLogs(t, lp, c, 5, 5)
+
+	// Earlier logs are replayed on demand
+	require.NoError(t, lp.ReplaySync(ctx, 1))
+	assertLogs(t, lp, c, 1, 5)
+	require.Error(t, lp.ReplaySync(ctx, 6))
+
+	c.extendTo(7)
+	lp.PollAndSaveLogs(ctx)
+	assertLogs(t, lp, c, 1, 7)
+
+	// Blocks 7 and 8 replace the stored block 7
+	c.reorg(7, 8)
+	lp.PollAndSaveLogs(ctx)
+	latest, err = lp.LatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), latest)
+	assertLogs(t, lp, c, 1, 8)
+
+	// Finalized blocks are backfilled by range
+	c.extendTo(15)
+	lp.PollAndSaveLogs(ctx)
+	assertLogs(t, lp, c, 1, 15)
+
+	l, err := lp.LatestLogByEventSigWithConfs(eventSig, address, 2)
+	require.NoError(t, err)
+	require.NotNil(t, l)
+	assert.Equal(t, int64(13), l.BlockNumber)
+
+	// Only the blocks needed for reorg detection are kept
+	b, err := orm.SelectBlockByNumber(12)
+	require.NoError(t, err)
+	assert.Nil(t, b)
+	b, err = orm.SelectBlockByNumber(13)
+	require.NoError(t, err)
+	require.NotNil(t, b)
+	assert.Equal(t, c.hash(13), b.BlockHash)
+}
+
+func TestLogPoller_EmptyFilter(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	orm := logpoller.NewORM(db, lggr, pgtest.NewPGCfg(true), *testutils.FixtureChainID)
+
+	address := testutils.NewAddress()
+	eventSig := common.HexToHash("0x1234")
+	c := newSimulatedChain(address, eventSig, 5)
+	ec := newClient(t, c)
+	lp := logpoller.NewTestLogPoller(orm, ec, testConfig{}, lggr)
+	ctx := testutils.Context(t)
+
+	// Nothing is polled until a filter is merged
+	lp.PollAndSaveLogs(ctx)
+	_, err := lp.LatestBlock()
+	require.Error(t, err)
+	ec.AssertNotCalled(t, "HeadByNumber", mock.Anything, mock.Anything)
+	ec.AssertNotCalled(t, "FilterLogs", mock.Anything, mock.Anything)
+
+	lp.MergeFilter([]common.Hash{eventSig}, []common.Address{address})
+	lp.PollAndSaveLogs(ctx)
+	latest, err := lp.LatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), latest)
+	assertLogs(t, lp, c, 5, 5)
+}
@@ -0,0 +1,85 @@
+package logpoller
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/lib/pq"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Log is a log matching a registered filter, as stored in the logs table.
+type Log struct {
+	EvmChainID  *utils.Big `db:"evm_chain_id"`
+	LogIndex    int64
+	BlockHash   common.Hash
+	BlockNumber int64
+	Address     common.Address
+	// EventSig is the first topic of the log
+	EventSig  common.Hash
+	Topics    pq.ByteaArray
+	TxHash    common.Hash
+	Data      []byte
+	CreatedAt time.Time
+}
+
+// GetTopics returns the topics of the log as hashes.
+func (l Log) GetTopics() []common.Hash {
+	topics := make([]common.Hash, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = common.BytesToHash(topic)
+	}
+	return topics
+}
+
+// ToGethLog converts the log back to the go-ethereum log type, as returned
+// by the client.
+func (l Log) ToGethLog() types.Log {
+	return types.Log{
+		Address:     l.Address,
+		Topics:      l.GetTopics(),
+		Data:        l.Data,
+		BlockNumber: uint64(l.BlockNumber),
+		TxHash:      l.TxHash,
+		BlockHash:   l.BlockHash,
+		Index:       uint(l.LogIndex),
+	}
+}
+
+// Block is a block that the log poller has processed. Only the most recent
+// blocks are kept, to detect reorgs by comparing their hashes with the chain.
+type Block struct {
+	EvmChainID  *utils.Big `db:"evm_chain_id"`
+	BlockHash   common.Hash
+	BlockNumber int64
+	CreatedAt   time.Time
+}
+
+// convertLogs converts logs returned by the client to the stored logs type.
+func convertLogs(chainID *utils.Big, logs []types.Log) []Log {
+	out := make([]Log, 0, len(logs))
+	for _, l := range logs {
+		if len(l.Topics) == 0 {
+			// Anonymous events cannot match a filter on event signatures
+			continue
+		}
+		topics := make(pq.ByteaArray, len(l.Topics))
+		for i, topic := range l.Topics {
+			topics[i] = topic.Bytes()
+		}
+		out = append(out, Log{
+			EvmChainID:  chainID,
+			LogIndex:    int64(l.Index),
+			BlockHash:   l.BlockHash,
+			BlockNumber: int64(l.BlockNumber),
+			Address:     l.Address,
+			EventSig:    l.Topics[0],
+			Topics:      topics,
+			TxHash:      l.TxHash,
+			Data:        l.Data,
+		})
+	}
+	return out
+}
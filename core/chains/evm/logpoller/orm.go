@@ -0,0 +1,198 @@
+package logpoller
+
+import (
+	"database/sql"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/sqlx"
+)
+
+// insertLogsBatchSize bounds the number of logs inserted per statement, to
+// stay well below the postgres limit on the number of parameters.
+const insertLogsBatchSize = 1000
+
+// ORM stores the logs matching the log poller's filters, and the most recent
+// blocks it processed.
+type ORM interface {
+	// InsertLogs inserts logs, ignoring those that are already stored.
+	InsertLogs(logs []Log, qopts ...pg.QOpt) error
+	// InsertBlock records that the block was processed.
+	InsertBlock(blockHash common.Hash, blockNumber int64, qopts ...pg.QOpt) error
+	// InsertBlockWithLogs inserts the logs of a block and records that the
+	// block was processed, atomically.
+	InsertBlockWithLogs(blockHash common.Hash, blockNumber int64, logs []Log, qopts ...pg.QOpt) error
+	// SelectBlockByNumber returns the processed block with the given number,
+	// or nil if there is none.
+	SelectBlockByNumber(blockNumber int64, qopts ...pg.QOpt) (*Block, error)
+	// SelectLatestBlock returns the most recently processed block, or nil if
+	// no block was processed yet.
+	SelectLatestBlock(qopts ...pg.QOpt) (*Block, error)
+	// DeleteBlocksAfter deletes the blocks, and their logs, from the given
+	// block number onwards. It is used to remove reorged blocks.
+	DeleteBlocksAfter(start int64, qopts ...pg.QOpt) error
+	// DeleteBlocksBefore deletes the blocks before the given block number,
+	// keeping their logs. It bounds the number of blocks kept for reorg
+	// detection.
+	DeleteBlocksBefore(end int64, qopts ...pg.QOpt) error
+
+	// SelectLogsByBlockRange returns the logs with the event signature
+	// emitted by the address between the start and end blocks, inclusive.
+	SelectLogsByBlockRange(start, end int64, address common.Address, eventSig common.Hash, qopts ...pg.QOpt) ([]Log, error)
+	// SelectLogsWithSigsByBlockRange returns the logs with any of the event
+	// signatures emitted by the address between the start and end blocks,
+	// inclusive.
+	SelectLogsWithSigsByBlockRange(start, end int64, address common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) ([]Log, error)
+	// SelectLatestLogEventSigWithConfs returns the most recent log with the
+	// event signature emitted by the address that has at least confs
+	// confirmations, or nil if there is none.
+	SelectLatestLogEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error)
+}
+
+type orm struct {
+	q          pg.Q
+	evmChainID utils.Big
+}
+
+var _ ORM = (*orm)(nil)
+
+// NewORM creates an ORM scoped to a chain.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig, evmChainID big.Int) ORM {
+	return &orm{pg.NewQ(db, lggr, cfg), *utils.NewBig(&evmChainID)}
+}
+
+func (o *orm) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		return o.insertLogs(tx, logs)
+	})
+	return errors.Wrap(err, "failed to insert logs")
+}
+
+func (o *orm) InsertBlock(blockHash common.Hash, blockNumber int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := o.insertBlock(q, blockHash, blockNumber)
+	return errors.Wrap(err, "failed to insert block")
+}
+
+func (o *orm) InsertBlockWithLogs(blockHash common.Hash, blockNumber int64, logs []Log, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if err := o.insertLogs(tx, logs); err != nil {
+			return err
+		}
+		return o.insertBlock(tx, blockHash, blockNumber)
+	})
+	return errors.Wrap(err, "failed to insert block with logs")
+}
+
+func (o *orm) insertLogs(tx pg.Queryer, logs []Log) error {
+	for i := range logs {
+		logs[i].EvmChainID = &o.evmChainID
+	}
+	for start := 0; start < len(logs); start += insertLogsBatchSize {
+		end := start + insertLogsBatchSize
+		if end > len(logs) {
+			end = len(logs)
+		}
+		_, err := tx.NamedExec(`
+		INSERT INTO logs (evm_chain_id, log_index, block_hash, block_number, address, event_sig, topics, tx_hash, data, created_at)
+		VALUES (:evm_chain_id, :log_index, :block_hash, :block_number, :address, :event_sig, :topics, :tx_hash, :data, NOW())
+		ON CONFLICT DO NOTHING`, logs[start:end])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *orm) insertBlock(q pg.Queryer, blockHash common.Hash, blockNumber int64) error {
+	_, err := q.Exec(`
+	INSERT INTO log_poller_blocks (evm_chain_id, block_hash, block_number, created_at)
+	VALUES ($1, $2, $3, NOW())
+	ON CONFLICT (block_number, evm_chain_id) DO UPDATE SET block_hash = EXCLUDED.block_hash, created_at = EXCLUDED.created_at`,
+		o.evmChainID, blockHash, blockNumber)
+	return err
+}
+
+func (o *orm) SelectBlockByNumber(blockNumber int64, qopts ...pg.QOpt) (*Block, error) {
+	var b Block
+	q := o.q.WithOpts(qopts...)
+	err := q.Get(&b, `SELECT * FROM log_poller_blocks WHERE block_number = $1 AND evm_chain_id = $2`, blockNumber, o.evmChainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &b, errors.Wrap(err, "failed to select block")
+}
+
+func (o *orm) SelectLatestBlock(qopts ...pg.QOpt) (*Block, error) {
+	var b Block
+	q := o.q.WithOpts(qopts...)
+	err := q.Get(&b, `SELECT * FROM log_poller_blocks WHERE evm_chain_id = $1 ORDER BY block_number DESC LIMIT 1`, o.evmChainID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &b, errors.Wrap(err, "failed to select latest block")
+}
+
+func (o *orm) DeleteBlocksAfter(start int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`DELETE FROM log_poller_blocks WHERE block_number >= $1 AND evm_chain_id = $2`, start, o.evmChainID); err != nil {
+			return err
+		}
+		_, err := tx.Exec(`DELETE FROM logs WHERE block_number >= $1 AND evm_chain_id = $2`, start, o.evmChainID)
+		return err
+	})
+	return errors.Wrap(err, "failed to delete reorged blocks")
+}
+
+func (o *orm) DeleteBlocksBefore(end int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.ExecQ(`DELETE FROM log_poller_blocks WHERE block_number < $1 AND evm_chain_id = $2`, end, o.evmChainID)
+	return errors.Wrap(err, "failed to delete old blocks")
+}
+
+func (o *orm) SelectLogsByBlockRange(start, end int64, address common.Address, eventSig common.Hash, qopts ...pg.QOpt) ([]Log, error) {
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `
+	SELECT * FROM logs
+	WHERE evm_chain_id = $1 AND address = $2 AND event_sig = $3 AND block_number >= $4 AND block_number <= $5
+	ORDER BY block_number, log_index`, o.evmChainID, address, eventSig, start, end)
+	return logs, errors.Wrap(err, "failed to select logs")
+}
+
+func (o *orm) SelectLogsWithSigsByBlockRange(start, end int64, address common.Address, eventSigs []common.Hash, qopts ...pg.QOpt) ([]Log, error) {
+	sigs := make(pq.ByteaArray, len(eventSigs))
+	for i, sig := range eventSigs {
+		sigs[i] = sig.Bytes()
+	}
+	var logs []Log
+	q := o.q.WithOpts(qopts...)
+	err := q.Select(&logs, `
+	SELECT * FROM logs
+	WHERE evm_chain_id = $1 AND address = $2 AND event_sig = ANY($3) AND block_number >= $4 AND block_number <= $5
+	ORDER BY block_number, log_index`, o.evmChainID, address, sigs, start, end)
+	return logs, errors.Wrap(err, "failed to select logs")
+}
+
+func (o *orm) SelectLatestLogEventSigWithConfs(eventSig common.Hash, address common.Address, confs int, qopts ...pg.QOpt) (*Log, error) {
+	var l Log
+	q := o.q.WithOpts(qopts...)
+	err := q.Get(&l, `
+	SELECT * FROM logs
+	WHERE evm_chain_id = $1 AND address = $2 AND event_sig = $3
+	AND block_number <= (SELECT COALESCE(MAX(block_number), 0) FROM log_poller_blocks WHERE evm_chain_id = $1) - $4
+	ORDER BY block_number DESC, log_index DESC LIMIT 1`, o.evmChainID, address, eventSig, confs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return &l, errors.Wrap(err, "failed to select latest log")
+}
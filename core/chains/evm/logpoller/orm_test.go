@@ -0,0 +1,108 @@
+package logpoller_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func newLog(blockNumber int64, blockHash common.Hash, logIndex int64, address common.Address, eventSig common.Hash) logpoller.Log {
+	return logpoller.Log{
+		LogIndex:    logIndex,
+		BlockHash:   blockHash,
+		BlockNumber: blockNumber,
+		Address:     address,
+		EventSig:    eventSig,
+		Topics:      pq.ByteaArray{eventSig.Bytes()},
+		TxHash:      common.Hash(testutils.Random32Byte()),
+		Data:        []byte("hello"),
+	}
+}
+
+func TestORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	orm := logpoller.NewORM(db, logger.TestLogger(t), pgtest.NewPGCfg(true), *testutils.FixtureChainID)
+
+	address := testutils.NewAddress()
+	eventSig := common.HexToHash("0x1234")
+	otherSig := common.HexToHash("0x5678")
+
+	latest, err := orm.SelectLatestBlock()
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	for n := int64(1); n <= 10; n++ {
+		hash := common.Hash(testutils.Random32Byte())
+		logs := []logpoller.Log{newLog(n, hash, 0, address, eventSig), newLog(n, hash, 1, address, otherSig)}
+		require.NoError(t, orm.InsertBlockWithLogs(hash, n, logs))
+	}
+	// Inserting a log again is ignored
+	block, err := orm.SelectBlockByNumber(10)
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	require.NoError(t, orm.InsertLogs([]logpoller.Log{newLog(10, block.BlockHash, 0, address, eventSig)}))
+
+	latest, err = orm.SelectLatestBlock()
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, int64(10), latest.BlockNumber)
+
+	logs, err := orm.SelectLogsByBlockRange(3, 5, address, eventSig)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Equal(t, int64(3), logs[0].BlockNumber)
+	assert.Equal(t, eventSig, logs[0].EventSig)
+	assert.Equal(t, []common.Hash{eventSig}, logs[0].GetTopics())
+	assert.Equal(t, []byte("hello"), logs[0].Data)
+
+	logs, err = orm.SelectLogsWithSigsByBlockRange(3, 5, address, []common.Hash{eventSig, otherSig})
+	require.NoError(t, err)
+	assert.Len(t, logs, 6)
+
+	logs, err = orm.SelectLogsByBlockRange(1, 10, testutils.NewAddress(), eventSig)
+	require.NoError(t, err)
+	assert.Len(t, logs, 0)
+
+	l, err := orm.SelectLatestLogEventSigWithConfs(eventSig, address, 0)
+	require.NoError(t, err)
+	require.NotNil(t, l)
+	assert.Equal(t, int64(10), l.BlockNumber)
+
+	l, err = orm.SelectLatestLogEventSigWithConfs(eventSig, address, 3)
+	require.NoError(t, err)
+	require.NotNil(t, l)
+	assert.Equal(t, int64(7), l.BlockNumber)
+
+	l, err = orm.SelectLatestLogEventSigWithConfs(eventSig, address, 10)
+	require.NoError(t, err)
+	assert.Nil(t, l)
+
+	// Reorged blocks are deleted with their logs
+	require.NoError(t, orm.DeleteBlocksAfter(8))
+	latest, err = orm.SelectLatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), latest.BlockNumber)
+	logs, err = orm.SelectLogsByBlockRange(1, 10, address, eventSig)
+	require.NoError(t, err)
+	assert.Len(t, logs, 7)
+
+	// Old blocks are deleted, but not their logs
+	require.NoError(t, orm.DeleteBlocksBefore(5))
+	block, err = orm.SelectBlockByNumber(4)
+	require.NoError(t, err)
+	assert.Nil(t, block)
+	block, err = orm.SelectBlockByNumber(5)
+	require.NoError(t, err)
+	assert.NotNil(t, block)
+	logs, err = orm.SelectLogsByBlockRange(1, 10, address, eventSig)
+	require.NoError(t, err)
+	assert.Len(t, logs, 7)
+}
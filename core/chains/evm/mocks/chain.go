@@ -15,6 +15,8 @@ import (
 
 	log "github.com/smartcontractkit/chainlink/core/chains/evm/log"
 
+	logpoller "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller"
+
 	logger "github.com/smartcontractkit/chainlink/core/logger"
 
 	mock "github.com/stretchr/testify/mock"
@@ -167,6 +169,22 @@ func (_m *Chain) LogBroadcaster() log.Broadcaster {
 	return r0
 }
 
+// LogPoller provides a mock function with given fields:
+func (_m *Chain) LogPoller() logpoller.LogPoller {
+	ret := _m.Called()
+
+	var r0 logpoller.LogPoller
+	if rf, ok := ret.Get(0).(func() logpoller.LogPoller); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logpoller.LogPoller)
+		}
+	}
+
+	return r0
+}
+
 // Logger provides a mock function with given fields:
 func (_m *Chain) Logger() logger.Logger {
 	ret := _m.Called()
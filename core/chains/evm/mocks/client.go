@@ -7,6 +7,8 @@ import (
 
 	assets "github.com/smartcontractkit/chainlink/core/assets"
 
+	client "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	context "context"
@@ -361,6 +363,22 @@ func (_m *Client) HeaderByNumber(_a0 context.Context, _a1 *big.Int) (*types.Head
 	return r0, r1
 }
 
+// NodeHeads provides a mock function with given fields: ctx
+func (_m *Client) NodeHeads(ctx context.Context) []client.NodeHead {
+	ret := _m.Called(ctx)
+
+	var r0 []client.NodeHead
+	if rf, ok := ret.Get(0).(func(context.Context) []client.NodeHead); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.NodeHead)
+		}
+	}
+
+	return r0
+}
+
 // NonceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Client) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
 	ret := _m.Called(ctx, account, blockNumber)
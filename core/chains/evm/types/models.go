@@ -30,6 +30,10 @@ type Head struct {
 	Timestamp     time.Time
 	CreatedAt     time.Time
 	BaseFeePerGas *utils.Big
+	// IsFinalized is set by the head tracker on the heads that are final,
+	// according to the chain's "finalized" block tag if enabled, or else to
+	// the finality depth. It is not persisted.
+	IsFinalized bool
 }
 
 // NewHead returns a Head instance.
@@ -59,6 +63,17 @@ func (h *Head) EarliestInChain() *Head {
 	return h
 }
 
+// LatestFinalizedHead returns the highest finalized head in the chain, or nil
+// if none of the heads in the chain is known to be finalized.
+func (h *Head) LatestFinalizedHead() *Head {
+	for ; h != nil; h = h.Parent {
+		if h.IsFinalized {
+			return h
+		}
+	}
+	return nil
+}
+
 // IsInChain returns true if the given hash matches the hash of a head in the chain
 func (h *Head) IsInChain(blockHash common.Hash) bool {
 	for {
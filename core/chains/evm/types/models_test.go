@@ -222,6 +222,24 @@ func TestHead_EarliestInChain(t *testing.T) {
 	assert.Equal(t, int64(1), head.EarliestInChain().Number)
 }
 
+func TestHead_LatestFinalizedHead(t *testing.T) {
+	head := evmtypes.Head{
+		Number: 3,
+		Parent: &evmtypes.Head{
+			Number: 2,
+			Parent: &evmtypes.Head{
+				Number:      1,
+				IsFinalized: true,
+			},
+		},
+	}
+
+	assert.Equal(t, int64(1), head.LatestFinalizedHead().Number)
+
+	head.Parent.Parent.IsFinalized = false
+	assert.Nil(t, head.LatestFinalizedHead())
+}
+
 func TestHead_IsInChain(t *testing.T) {
 	hash1 := utils.NewHash()
 	hash2 := utils.NewHash()
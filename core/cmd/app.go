@@ -95,6 +95,18 @@ func NewApp(client *Client) *cli.App {
 							Name:  "block-number",
 							Usage: "Block number to replay from",
 						},
+						cli.IntFlag{
+							Name:  "job",
+							Usage: "Only replay logs to the listeners of this job ID",
+						},
+						cli.StringFlag{
+							Name:  "contract",
+							Usage: "Only replay logs to the listeners on this contract address",
+						},
+						cli.BoolFlag{
+							Name:  "force",
+							Usage: "Re-process logs that were already consumed (requires --job or --contract)",
+						},
 					},
 				},
 			},
@@ -236,6 +248,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "rerun",
+					Usage:  "Re-run a finished pipeline run with the same inputs",
+					Action: client.RerunPipelineRun,
+				},
 			},
 		},
 		{
@@ -895,6 +912,45 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "secrets",
+			Usage: "Commands for managing encrypted secrets that job specs can reference as $(secrets.<name>)",
+			Subcommands: []cli.Command{
+				{
+					Name:   "create",
+					Usage:  format(`Create a secret with the given name, reading its value from a file`),
+					Action: client.CreateSecret,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "`FILE` containing the secret value (required)",
+						},
+					},
+				},
+				{
+					Name:   "update",
+					Usage:  format(`Replace the value of an existing secret, reading the new value from a file`),
+					Action: client.UpdateSecret,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "`FILE` containing the secret value (required)",
+						},
+					},
+				},
+				{
+					Name:   "delete",
+					Usage:  format(`Delete the secret with the given name`),
+					Action: client.DeleteSecret,
+				},
+				{
+					Name:   "list",
+					Usage:  format(`List the names of all secrets (values are never shown)`),
+					Action: client.ListSecrets,
+				},
+			},
+		},
+
 		{
 			Name:  "txs",
 			Usage: "Commands for handling Ethereum transactions",
@@ -116,6 +116,14 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 		if p.BootstrapSpec != nil {
 			return p.BootstrapSpec.CreatedAt.Format(time.RFC3339)
 		}
+	case presenters.EVMLogJobSpec:
+		if p.EVMLogSpec != nil {
+			return p.EVMLogSpec.CreatedAt.Format(time.RFC3339)
+		}
+	case presenters.BlockTriggerJobSpec:
+		if p.BlockTriggerSpec != nil {
+			return p.BlockTriggerSpec.CreatedAt.Format(time.RFC3339)
+		}
 	default:
 		return "unknown"
 	}
@@ -257,3 +265,24 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// RerunPipelineRun executes the pipeline of a finished run again with the
+// inputs of that run.
+func (cli *Client) RerunPipelineRun(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the pipeline run id to re-run"))
+	}
+	resp, err := cli.HTTP.Post("/v2/pipeline/runs/"+c.Args().First()+"/rerun", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var run presenters.PipelineRunResource
+	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully re-run")
+	return err
+}
@@ -127,6 +127,7 @@ DEFAULT_HTTP_LIMIT: 32768
 DEFAULT_HTTP_TIMEOUT: 15s
 CHAINLINK_DEV: false
 SHUTDOWN_GRACE_PERIOD: 5s
+EVM_LOG_LISTENER_OVERFLOW_POLICY: drop-oldest
 EVM_RPC_ENABLED: true
 ETH_HTTP_URL: 
 ETH_SECONDARY_URLS: []
@@ -134,12 +135,16 @@ ETH_URL:
 EXPLORER_URL: 
 FM_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
 FEATURE_EXTERNAL_INITIATORS: false
+FEATURE_LOG_POLLER: false
 FEATURE_OFFCHAIN_REPORTING: false
 GAS_ESTIMATOR_MODE: 
 INSECURE_FAST_SCRYPT: false
 JSON_CONSOLE: false
 JOB_PIPELINE_REAPER_INTERVAL: 1h0m0s
 JOB_PIPELINE_REAPER_THRESHOLD: 24h0m0s
+JOB_PIPELINE_REAPER_FAILED_THRESHOLD: 
+JOB_PIPELINE_REAPER_MAX_RUNS: 0
+JOB_PIPELINE_REAPER_EXPORT_DIR: 
 KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH: 1
 KEEPER_GAS_PRICE_BUFFER_PERCENT: 20
 KEEPER_GAS_TIP_CAP_BUFFER_PERCENT: 20
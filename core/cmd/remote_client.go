@@ -119,7 +119,8 @@ func (cli *Client) getPage(requestURI string, page int, model interface{}) (err
 	return err
 }
 
-// ReplayFromBlock replays chain data from the given block number until the most recent
+// ReplayFromBlock replays chain data from the given block number until the most recent,
+// optionally only to the log listeners of a job and/or on a contract
 func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 
 	blockNumber := c.Int64("block-number")
@@ -127,9 +128,27 @@ func (cli *Client) ReplayFromBlock(c *clipkg.Context) (err error) {
 		return cli.errorOut(errors.New("Must pass a positive value in '--block-number' parameter"))
 	}
 
+	params := url.Values{}
+	if c.IsSet("job") {
+		params.Set("jobID", strconv.FormatInt(c.Int64("job"), 10))
+	}
+	if c.IsSet("contract") {
+		params.Set("contract", c.String("contract"))
+	}
+	if c.Bool("force") {
+		if !c.IsSet("job") && !c.IsSet("contract") {
+			return cli.errorOut(errors.New("'--force' requires '--job' or '--contract'"))
+		}
+		params.Set("force", "true")
+	}
+	requestURI := fmt.Sprintf("/v2/replay_from_block/%v", blockNumber)
+	if len(params) > 0 {
+		requestURI += "?" + params.Encode()
+	}
+
 	buf := bytes.NewBufferString("{}")
 
-	resp, err := cli.HTTP.Post(fmt.Sprintf("/v2/replay_from_block/%v", blockNumber), buf)
+	resp, err := cli.HTTP.Post(requestURI, buf)
 	if err != nil {
 		return cli.errorOut(err)
 	}
@@ -141,6 +141,19 @@ func TestClient_ReplayBlocks(t *testing.T) {
 	set.Int64("block-number", 42, "")
 	c := cli.NewContext(nil, set, nil)
 	assert.NoError(t, client.ReplayFromBlock(c))
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.Bool("force", true, "")
+	c = cli.NewContext(nil, set, nil)
+	assert.EqualError(t, client.ReplayFromBlock(c), "'--force' requires '--job' or '--contract'")
+
+	set = flag.NewFlagSet("flagset", 0)
+	set.Int64("block-number", 42, "")
+	set.Int64("job", 0, "")
+	require.NoError(t, set.Set("job", "999"))
+	c = cli.NewContext(nil, set, nil)
+	assert.Error(t, client.ReplayFromBlock(c))
 }
 
 func TestClient_CreateExternalInitiator(t *testing.T) {
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+type SecretPresenter struct {
+	JAID
+	presenters.SecretResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SecretPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Created", "Updated"})
+	table.Append(p.ToRow())
+	render("Secret", table)
+	return nil
+}
+
+func (p *SecretPresenter) ToRow() []string {
+	return []string{
+		p.Name,
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+}
+
+type SecretPresenters []SecretPresenter
+
+// RenderTable implements TableRenderer
+func (ps SecretPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Name", "Created", "Updated"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+	render("Secrets", table)
+	return utils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListSecrets lists the names of all secrets stored on the node
+func (cli *Client) ListSecrets(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get("/v2/secrets", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenters{})
+}
+
+// CreateSecret stores a new secret, reading its value from the file passed
+// with --file so that it never appears in the shell history
+func (cli *Client) CreateSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret to be created"))
+	}
+	body, err := secretRequestBody(c.Args().First(), c.String("file"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/secrets", body)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenter{}, "Created secret")
+}
+
+// UpdateSecret replaces the value of an existing secret with the contents of
+// the file passed with --file
+func (cli *Client) UpdateSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret to be updated"))
+	}
+	name := c.Args().First()
+	body, err := secretRequestBody(name, c.String("file"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Patch("/v2/secrets/"+name, body)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenter{}, "Updated secret")
+}
+
+// DeleteSecret deletes a secret by name
+func (cli *Client) DeleteSecret(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the name of the secret to be deleted"))
+	}
+	resp, err := cli.HTTP.Delete("/v2/secrets/" + c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SecretPresenter{}, "Deleted secret")
+}
+
+func secretRequestBody(name, file string) (*bytes.Buffer, error) {
+	if file == "" {
+		return nil, errors.New("must specify the --file flag")
+	}
+	value, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read secret file")
+	}
+	request := web.SecretRequest{
+		Name:  name,
+		Value: strings.TrimRight(string(value), "\r\n"),
+	}
+	bs, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewBuffer(bs), nil
+}
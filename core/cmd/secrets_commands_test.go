@@ -0,0 +1,63 @@
+package cmd_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+func TestClient_CreateListUpdateDeleteSecret(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	valueFile := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, ioutil.WriteFile(valueFile, []byte("s3cr3t\n"), 0600))
+
+	// Missing --file
+	set := flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"apiKey"}))
+	set.String("file", "", "")
+	require.Error(t, client.CreateSecret(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"apiKey"}))
+	set.String("file", valueFile, "")
+	require.NoError(t, client.CreateSecret(cli.NewContext(nil, set, nil)))
+
+	values, err := app.GetKeyStore().Secrets().GetAllValues()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"apiKey": "s3cr3t"}, values)
+
+	require.NoError(t, client.ListSecrets(cltest.EmptyCLIContext()))
+	secrets := *r.Renders[len(r.Renders)-1].(*cmd.SecretPresenters)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "apiKey", secrets[0].Name)
+
+	require.NoError(t, ioutil.WriteFile(valueFile, []byte("n3w"), 0600))
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"apiKey"}))
+	set.String("file", valueFile, "")
+	require.NoError(t, client.UpdateSecret(cli.NewContext(nil, set, nil)))
+
+	values, err = app.GetKeyStore().Secrets().GetAllValues()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"apiKey": "n3w"}, values)
+
+	set = flag.NewFlagSet("test", 0)
+	require.NoError(t, set.Parse([]string{"apiKey"}))
+	require.NoError(t, client.DeleteSecret(cli.NewContext(nil, set, nil)))
+
+	all, err := app.GetKeyStore().Secrets().GetAll()
+	require.NoError(t, err)
+	assert.Len(t, all, 0)
+}
@@ -98,10 +98,12 @@ type ConfigSchema struct {
 	FeatureUICSAKeys    bool `env:"FEATURE_UI_CSA_KEYS" default:"false"`   //nodoc
 
 	// General chains/RPC
-	EVMEnabled    bool `env:"EVM_ENABLED" default:"true"`
-	EVMRPCEnabled bool `env:"EVM_RPC_ENABLED" default:"true"`
-	SolanaEnabled bool `env:"SOLANA_ENABLED" default:"false"`
-	TerraEnabled  bool `env:"TERRA_ENABLED" default:"false"`
+	EVMEnabled                   bool   `env:"EVM_ENABLED" default:"true"`
+	EVMLogListenerOverflowPolicy string `env:"EVM_LOG_LISTENER_OVERFLOW_POLICY" default:"drop-oldest"`
+	EVMRPCEnabled                bool   `env:"EVM_RPC_ENABLED" default:"true"`
+	FeatureLogPoller             bool   `env:"FEATURE_LOG_POLLER" default:"false"`
+	SolanaEnabled                bool   `env:"SOLANA_ENABLED" default:"false"`
+	TerraEnabled                 bool   `env:"TERRA_ENABLED" default:"false"`
 
 	// EVM/Ethereum
 	// Legacy Eth ENV vars
@@ -120,10 +122,12 @@ type ConfigSchema struct {
 	EthTxReaperThreshold              time.Duration `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold         time.Duration `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
 	EvmFinalityDepth                  uint32        `env:"ETH_FINALITY_DEPTH"`
+	EvmFinalityTagEnabled             bool          `env:"ETH_FINALITY_TAG_ENABLED"`
 	EvmHeadTrackerHistoryDepth        uint          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
 	EvmHeadTrackerMaxBufferSize       uint          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
 	EvmHeadTrackerSamplingInterval    time.Duration `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
 	EvmLogBackfillBatchSize           uint32        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
+	EvmLogPollInterval                time.Duration `env:"ETH_LOG_POLL_INTERVAL"`
 	EvmRPCDefaultBatchSize            uint32        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	LinkContractAddress               string        `env:"LINK_CONTRACT_ADDRESS"`
 	MinIncomingConfirmations          uint32        `env:"MIN_INCOMING_CONFIRMATIONS"`
@@ -163,6 +167,9 @@ type ConfigSchema struct {
 	JobPipelineMaxRunDuration                 time.Duration   `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
 	JobPipelineReaperInterval                 time.Duration   `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                time.Duration   `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
+	JobPipelineReaperFailedThreshold          time.Duration   `env:"JOB_PIPELINE_REAPER_FAILED_THRESHOLD" default:"0s"`
+	JobPipelineReaperMaxRuns                  uint32          `env:"JOB_PIPELINE_REAPER_MAX_RUNS" default:"0"`
+	JobPipelineReaperExportDir                string          `env:"JOB_PIPELINE_REAPER_EXPORT_DIR"`
 	JobPipelineResultWriteQueueDepth          uint64          `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
 
 	// Flux Monitor
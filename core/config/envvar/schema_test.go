@@ -55,6 +55,7 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultHTTPTimeout":                             "DEFAULT_HTTP_TIMEOUT",
 		"Dev":                                            "CHAINLINK_DEV",
 		"EVMEnabled":                                     "EVM_ENABLED",
+		"EVMLogListenerOverflowPolicy":                   "EVM_LOG_LISTENER_OVERFLOW_POLICY",
 		"EVMRPCEnabled":                                  "EVM_RPC_ENABLED",
 		"EthTxReaperInterval":                            "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                           "ETH_TX_REAPER_THRESHOLD",
@@ -67,6 +68,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmDefaultBatchSize":                            "ETH_DEFAULT_BATCH_SIZE",
 		"EvmEIP1559DynamicFees":                          "EVM_EIP1559_DYNAMIC_FEES",
 		"EvmFinalityDepth":                               "ETH_FINALITY_DEPTH",
+		"EvmFinalityTagEnabled":                          "ETH_FINALITY_TAG_ENABLED",
 		"EvmGasBumpPercent":                              "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                            "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                              "ETH_GAS_BUMP_TX_DEPTH",
@@ -82,6 +84,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmHeadTrackerMaxBufferSize":                    "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EvmHeadTrackerSamplingInterval":                 "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
 		"EvmLogBackfillBatchSize":                        "ETH_LOG_BACKFILL_BATCH_SIZE",
+		"EvmLogPollInterval":                             "ETH_LOG_POLL_INTERVAL",
 		"EvmMaxGasPriceWei":                              "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                     "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                       "ETH_MAX_QUEUED_TRANSACTIONS",
@@ -95,6 +98,7 @@ func TestConfigSchema(t *testing.T) {
 		"FMSimulateTransactions":                         "FM_SIMULATE_TRANSACTIONS",
 		"FeatureExternalInitiators":                      "FEATURE_EXTERNAL_INITIATORS",
 		"FeatureFeedsManager":                            "FEATURE_FEEDS_MANAGER",
+		"FeatureLogPoller":                               "FEATURE_LOG_POLLER",
 		"FeatureOffchainReporting":                       "FEATURE_OFFCHAIN_REPORTING",
 		"FeatureOffchainReporting2":                      "FEATURE_OFFCHAIN_REPORTING2",
 		"FeatureUICSAKeys":                               "FEATURE_UI_CSA_KEYS",
@@ -109,7 +113,10 @@ func TestConfigSchema(t *testing.T) {
 		"InsecureSkipVerify":                             "INSECURE_SKIP_VERIFY",
 		"JSONConsole":                                    "JSON_CONSOLE",
 		"JobPipelineMaxRunDuration":                      "JOB_PIPELINE_MAX_RUN_DURATION",
+		"JobPipelineReaperExportDir":                     "JOB_PIPELINE_REAPER_EXPORT_DIR",
+		"JobPipelineReaperFailedThreshold":               "JOB_PIPELINE_REAPER_FAILED_THRESHOLD",
 		"JobPipelineReaperInterval":                      "JOB_PIPELINE_REAPER_INTERVAL",
+		"JobPipelineReaperMaxRuns":                       "JOB_PIPELINE_REAPER_MAX_RUNS",
 		"JobPipelineReaperThreshold":                     "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":               "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
 		"KeeperCheckUpkeepGasPriceFeatureEnabled":        "KEEPER_CHECK_UPKEEP_GAS_PRICE_FEATURE_ENABLED",
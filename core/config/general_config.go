@@ -46,6 +46,7 @@ var (
 type FeatureFlags interface {
 	FeatureExternalInitiators() bool
 	FeatureFeedsManager() bool
+	FeatureLogPoller() bool
 	FeatureOffchainReporting() bool
 	FeatureOffchainReporting2() bool
 	FeatureUICSAKeys() bool
@@ -108,6 +109,7 @@ type GeneralOnlyConfig interface {
 	EthereumHTTPURL() *url.URL
 	EthereumSecondaryURLs() []url.URL
 	EthereumURL() string
+	EVMLogListenerOverflowPolicy() string
 	ExplorerAccessKey() string
 	ExplorerSecret() string
 	ExplorerURL() *url.URL
@@ -122,6 +124,9 @@ type GeneralOnlyConfig interface {
 	JobPipelineMaxRunDuration() time.Duration
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
+	JobPipelineReaperFailedThreshold() time.Duration
+	JobPipelineReaperMaxRuns() uint32
+	JobPipelineReaperExportDir() string
 	JobPipelineResultWriteQueueDepth() uint64
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint32
@@ -187,6 +192,7 @@ type GlobalConfig interface {
 	GlobalEvmDefaultBatchSize() (uint32, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
+	GlobalEvmFinalityTagEnabled() (bool, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
@@ -202,6 +208,7 @@ type GlobalConfig interface {
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
+	GlobalEvmLogPollInterval() (time.Duration, bool)
 	GlobalEvmMaxGasPriceWei() (*big.Int, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
@@ -380,6 +387,12 @@ EVM_ENABLED=false
 		return errors.Errorf("unrecognised value for DATABASE_LOCKING_MODE: %s (valid options are 'dual', 'lease', 'advisorylock' or 'none')", c.DatabaseLockingMode())
 	}
 
+	switch c.EVMLogListenerOverflowPolicy() {
+	case "block", "drop-oldest", "spill":
+	default:
+		return errors.Errorf("unrecognised value for EVM_LOG_LISTENER_OVERFLOW_POLICY: %s (valid options are 'block', 'drop-oldest' or 'spill')", c.EVMLogListenerOverflowPolicy())
+	}
+
 	if c.LeaseLockRefreshInterval() > c.LeaseLockDuration()/2 {
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
@@ -609,6 +622,12 @@ func (c *generalConfig) FeatureExternalInitiators() bool {
 	return c.viper.GetBool(envvar.Name("FeatureExternalInitiators"))
 }
 
+// FeatureLogPoller enables the log poller, which stores the logs that jobs
+// filter for in the database.
+func (c *generalConfig) FeatureLogPoller() bool {
+	return c.viper.GetBool(envvar.Name("FeatureLogPoller"))
+}
+
 // FeatureFeedsManager enables the feeds manager
 func (c *generalConfig) FeatureFeedsManager() bool {
 	return c.viper.GetBool(envvar.Name("FeatureFeedsManager"))
@@ -698,6 +717,13 @@ func (c *generalConfig) EVMRPCEnabled() bool {
 	return rpcEnabled
 }
 
+// EVMLogListenerOverflowPolicy is what listener queues do with a log when
+// they are full: 'block' the log broadcaster until there is room, drop the
+// oldest log ('drop-oldest'), or 'spill' the log to the database.
+func (c *generalConfig) EVMLogListenerOverflowPolicy() string {
+	return c.getWithFallback("EVMLogListenerOverflowPolicy", parse.String).(string)
+}
+
 // EVMEnabled allows EVM chains to be used
 func (c *generalConfig) EVMEnabled() bool {
 	if evmDisabled, exists := os.LookupEnv("EVM_DISABLED"); exists {
@@ -762,6 +788,25 @@ func (c *generalConfig) JobPipelineReaperThreshold() time.Duration {
 	return c.getWithFallback("JobPipelineReaperThreshold", parse.Duration).(time.Duration)
 }
 
+// JobPipelineReaperFailedThreshold is how long errored runs are kept. If
+// zero, errored runs are kept as long as JobPipelineReaperThreshold.
+func (c *generalConfig) JobPipelineReaperFailedThreshold() time.Duration {
+	return c.getWithFallback("JobPipelineReaperFailedThreshold", parse.Duration).(time.Duration)
+}
+
+// JobPipelineReaperMaxRuns is the maximum number of completed runs kept per
+// job, in addition to the age limit of JobPipelineReaperThreshold. Zero means
+// no limit.
+func (c *generalConfig) JobPipelineReaperMaxRuns() uint32 {
+	return c.getWithFallback("JobPipelineReaperMaxRuns", parse.Uint32).(uint32)
+}
+
+// JobPipelineReaperExportDir, if set, is the directory that runs are exported
+// to as gzipped JSON lines before the reaper deletes them.
+func (c *generalConfig) JobPipelineReaperExportDir() string {
+	return c.viper.GetString(envvar.Name("JobPipelineReaperExportDir"))
+}
+
 // KeeperRegistryCheckGasOverhead is the amount of extra gas to provide checkUpkeep() calls
 // to account for the gas consumed by the keeper registry
 func (c *generalConfig) KeeperRegistryCheckGasOverhead() uint64 {
@@ -1200,6 +1245,13 @@ func (c *generalConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (c *generalConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmFinalityTagEnabled"), parse.Bool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (c *generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmGasBumpPercent"), parse.Uint16)
 	if val == nil {
@@ -1298,6 +1350,13 @@ func (c *generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (c *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	val, ok := c.lookupEnv(envvar.Name("EvmLogPollInterval"), parse.Duration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
 func (c *generalConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	val, ok := c.lookupEnv(envvar.Name("EvmMaxGasPriceWei"), parse.BigInt)
 	if val == nil {
@@ -611,6 +611,20 @@ func (_m *GeneralConfig) EVMEnabled() bool {
 	return r0
 }
 
+// EVMLogListenerOverflowPolicy provides a mock function with given fields:
+func (_m *GeneralConfig) EVMLogListenerOverflowPolicy() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EVMRPCEnabled provides a mock function with given fields:
 func (_m *GeneralConfig) EVMRPCEnabled() bool {
 	ret := _m.Called()
@@ -771,6 +785,20 @@ func (_m *GeneralConfig) FeatureFeedsManager() bool {
 	return r0
 }
 
+// FeatureLogPoller provides a mock function with given fields:
+func (_m *GeneralConfig) FeatureLogPoller() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FeatureOffchainReporting provides a mock function with given fields:
 func (_m *GeneralConfig) FeatureOffchainReporting() bool {
 	ret := _m.Called()
@@ -1135,6 +1163,27 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmGasBumpPercent provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
@@ -1460,6 +1509,27 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogPollInterval provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmMaxGasPriceWei provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
@@ -1872,6 +1942,34 @@ func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperExportDir provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineReaperExportDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// JobPipelineReaperFailedThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineReaperFailedThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -1886,6 +1984,20 @@ func (_m *GeneralConfig) JobPipelineReaperInterval() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperMaxRuns provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineReaperMaxRuns() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperThreshold provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineReaperThreshold() time.Duration {
 	ret := _m.Called()
@@ -47,6 +47,7 @@ type EnvPrinter struct {
 	DefaultHTTPTimeout                         models.Duration `json:"DEFAULT_HTTP_TIMEOUT"`
 	Dev                                        bool            `json:"CHAINLINK_DEV"`
 	ShutdownGracePeriod                        time.Duration   `json:"SHUTDOWN_GRACE_PERIOD"`
+	EVMLogListenerOverflowPolicy               string          `json:"EVM_LOG_LISTENER_OVERFLOW_POLICY"`
 	EVMRPCEnabled                              bool            `json:"EVM_RPC_ENABLED"`
 	EthereumHTTPURL                            string          `json:"ETH_HTTP_URL"`
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
@@ -54,12 +55,16 @@ type EnvPrinter struct {
 	ExplorerURL                                string          `json:"EXPLORER_URL"`
 	FMDefaultTransactionQueueDepth             uint32          `json:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
 	FeatureExternalInitiators                  bool            `json:"FEATURE_EXTERNAL_INITIATORS"`
+	FeatureLogPoller                           bool            `json:"FEATURE_LOG_POLLER"`
 	FeatureOffchainReporting                   bool            `json:"FEATURE_OFFCHAIN_REPORTING"`
 	GasEstimatorMode                           string          `json:"GAS_ESTIMATOR_MODE"`
 	InsecureFastScrypt                         bool            `json:"INSECURE_FAST_SCRYPT"`
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
 	JobPipelineReaperThreshold                 time.Duration   `json:"JOB_PIPELINE_REAPER_THRESHOLD"`
+	JobPipelineReaperFailedThreshold           time.Duration   `json:"JOB_PIPELINE_REAPER_FAILED_THRESHOLD"`
+	JobPipelineReaperMaxRuns                   uint32          `json:"JOB_PIPELINE_REAPER_MAX_RUNS"`
+	JobPipelineReaperExportDir                 string          `json:"JOB_PIPELINE_REAPER_EXPORT_DIR"`
 	KeeperDefaultTransactionQueueDepth         uint32          `json:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
 	KeeperGasPriceBufferPercent                uint32          `json:"KEEPER_GAS_PRICE_BUFFER_PERCENT"`
 	KeeperGasTipCapBufferPercent               uint32          `json:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT"`
@@ -153,6 +158,7 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			DefaultHTTPTimeout:                 cfg.DefaultHTTPTimeout(),
 			Dev:                                cfg.Dev(),
 			ShutdownGracePeriod:                cfg.ShutdownGracePeriod(),
+			EVMLogListenerOverflowPolicy:       cfg.EVMLogListenerOverflowPolicy(),
 			EVMRPCEnabled:                      cfg.EVMRPCEnabled(),
 			EthereumHTTPURL:                    ethereumHTTPURL,
 			EthereumSecondaryURLs:              mapToStringA(cfg.EthereumSecondaryURLs()),
@@ -160,11 +166,15 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 			ExplorerURL:                        explorerURL,
 			FMDefaultTransactionQueueDepth:     cfg.FMDefaultTransactionQueueDepth(),
 			FeatureExternalInitiators:          cfg.FeatureExternalInitiators(),
+			FeatureLogPoller:                   cfg.FeatureLogPoller(),
 			FeatureOffchainReporting:           cfg.FeatureOffchainReporting(),
 			InsecureFastScrypt:                 cfg.InsecureFastScrypt(),
 			JSONConsole:                        cfg.JSONConsole(),
 			JobPipelineReaperInterval:          cfg.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:         cfg.JobPipelineReaperThreshold(),
+			JobPipelineReaperFailedThreshold:   cfg.JobPipelineReaperFailedThreshold(),
+			JobPipelineReaperMaxRuns:           cfg.JobPipelineReaperMaxRuns(),
+			JobPipelineReaperExportDir:         cfg.JobPipelineReaperExportDir(),
 			KeeperDefaultTransactionQueueDepth: cfg.KeeperDefaultTransactionQueueDepth(),
 			KeeperGasPriceBufferPercent:        cfg.KeeperGasPriceBufferPercent(),
 			KeeperGasTipCapBufferPercent:       cfg.KeeperGasTipCapBufferPercent(),
@@ -5,6 +5,8 @@ package mocks
 import (
 	big "math/big"
 
+	bridgehealth "github.com/smartcontractkit/chainlink/core/services/bridgehealth"
+
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 
@@ -20,6 +22,8 @@ import (
 
 	keystore "github.com/smartcontractkit/chainlink/core/services/keystore"
 
+	log "github.com/smartcontractkit/chainlink/core/chains/evm/log"
+
 	logger "github.com/smartcontractkit/chainlink/core/logger"
 
 	mock "github.com/stretchr/testify/mock"
@@ -126,6 +130,22 @@ func (_m *Application) EVMORM() types.ORM {
 	return r0
 }
 
+// GetBridgeHealthMonitor provides a mock function with given fields:
+func (_m *Application) GetBridgeHealthMonitor() bridgehealth.Monitor {
+	ret := _m.Called()
+
+	var r0 bridgehealth.Monitor
+	if rf, ok := ret.Get(0).(func() bridgehealth.Monitor); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bridgehealth.Monitor)
+		}
+	}
+
+	return r0
+}
+
 // GetChains provides a mock function with given fields:
 func (_m *Application) GetChains() chainlink.Chains {
 	ret := _m.Called()
@@ -360,6 +380,41 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	return r0
 }
 
+// ReplayListeners provides a mock function with given fields: chainID, opts
+func (_m *Application) ReplayListeners(chainID *big.Int, opts log.ReplayListenersOpts) error {
+	ret := _m.Called(chainID, opts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int, log.ReplayListenersOpts) error); ok {
+		r0 = rf(chainID, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RerunPipelineRunV2 provides a mock function with given fields: ctx, runID
+func (_m *Application) RerunPipelineRunV2(ctx context.Context, runID int64) (int64, error) {
+	ret := _m.Called(ctx, runID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, int64) int64); ok {
+		r0 = rf(ctx, runID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
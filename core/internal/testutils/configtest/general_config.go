@@ -53,10 +53,12 @@ type GeneralConfigOverrides struct {
 	ShutdownGracePeriod                       *time.Duration
 	Dialect                                   dialects.DialectName
 	EVMEnabled                                null.Bool
+	EVMLogListenerOverflowPolicy              null.String
 	EVMRPCEnabled                             null.Bool
 	EthereumURL                               null.String
 	FeatureExternalInitiators                 null.Bool
 	FeatureFeedsManager                       null.Bool
+	FeatureLogPoller                          null.Bool
 	GlobalBalanceMonitorEnabled               null.Bool
 	GlobalBlockEmissionIdleWarningThreshold   *time.Duration
 	GlobalChainType                           null.String
@@ -64,6 +66,7 @@ type GeneralConfigOverrides struct {
 	GlobalEthTxResendAfterThreshold           *time.Duration
 	GlobalEvmEIP1559DynamicFees               null.Bool
 	GlobalEvmFinalityDepth                    null.Int
+	GlobalEvmFinalityTagEnabled               null.Bool
 	GlobalEvmGasBumpPercent                   null.Int
 	GlobalEvmGasBumpTxDepth                   null.Int
 	GlobalEvmGasBumpWei                       *big.Int
@@ -77,6 +80,7 @@ type GeneralConfigOverrides struct {
 	GlobalEvmHeadTrackerMaxBufferSize         null.Int
 	GlobalEvmHeadTrackerSamplingInterval      *time.Duration
 	GlobalEvmLogBackfillBatchSize             null.Int
+	GlobalEvmLogPollInterval                  *time.Duration
 	GlobalEvmMaxGasPriceWei                   *big.Int
 	GlobalEvmMinGasPriceWei                   *big.Int
 	GlobalEvmNonceAutoSync                    null.Bool
@@ -250,6 +254,14 @@ func (c *TestGeneralConfig) ORMMaxOpenConns() int {
 	return 20
 }
 
+// EVMLogListenerOverflowPolicy overrides
+func (c *TestGeneralConfig) EVMLogListenerOverflowPolicy() string {
+	if c.Overrides.EVMLogListenerOverflowPolicy.Valid {
+		return c.Overrides.EVMLogListenerOverflowPolicy.String
+	}
+	return c.GeneralConfig.EVMLogListenerOverflowPolicy()
+}
+
 // EVMRPCEnabled overrides
 func (c *TestGeneralConfig) EVMRPCEnabled() bool {
 	if c.Overrides.EVMRPCEnabled.Valid {
@@ -313,6 +325,13 @@ func (c *TestGeneralConfig) FeatureExternalInitiators() bool {
 	return c.GeneralConfig.FeatureExternalInitiators()
 }
 
+func (c *TestGeneralConfig) FeatureLogPoller() bool {
+	if c.Overrides.FeatureLogPoller.Valid {
+		return c.Overrides.FeatureLogPoller.Bool
+	}
+	return c.GeneralConfig.FeatureLogPoller()
+}
+
 func (c *TestGeneralConfig) FeatureFeedsManager() bool {
 	if c.Overrides.FeatureFeedsManager.Valid {
 		return c.Overrides.FeatureFeedsManager.Bool
@@ -532,6 +551,13 @@ func (c *TestGeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmFinalityDepth()
 }
 
+func (c *TestGeneralConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	if c.Overrides.GlobalEvmFinalityTagEnabled.Valid {
+		return c.Overrides.GlobalEvmFinalityTagEnabled.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmFinalityTagEnabled()
+}
+
 func (c *TestGeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	if c.Overrides.GlobalEvmLogBackfillBatchSize.Valid {
 		return uint32(c.Overrides.GlobalEvmLogBackfillBatchSize.Int64), true
@@ -539,6 +565,13 @@ func (c *TestGeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return c.GeneralConfig.GlobalEvmLogBackfillBatchSize()
 }
 
+func (c *TestGeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
+	if c.Overrides.GlobalEvmLogPollInterval != nil {
+		return *c.Overrides.GlobalEvmLogPollInterval, true
+	}
+	return c.GeneralConfig.GlobalEvmLogPollInterval()
+}
+
 func (c *TestGeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	if c.Overrides.GlobalEvmMaxGasPriceWei != nil {
 		return c.Overrides.GlobalEvmMaxGasPriceWei, true
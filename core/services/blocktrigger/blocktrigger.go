@@ -0,0 +1,153 @@
+package blocktrigger
+
+import (
+	"context"
+	"sync"
+
+	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// BlockTrigger runs a blocktrigger job every BlockInterval heads.
+type BlockTrigger struct {
+	logger          logger.Logger
+	jobSpec         job.Job
+	pipelineRunner  pipeline.Runner
+	headBroadcaster httypes.HeadBroadcasterRegistry
+	blockInterval   int64
+	unsubscribe     func()
+
+	mu             sync.Mutex
+	lastHeadNumber *int64
+	running        bool
+
+	wg     sync.WaitGroup
+	chStop chan struct{}
+	utils.StartStopOnce
+}
+
+var _ httypes.HeadTrackable = &BlockTrigger{}
+
+// NewBlockTrigger creates a service running jobSpec on the heads of
+// headBroadcaster.
+func NewBlockTrigger(
+	jobSpec job.Job,
+	pipelineRunner pipeline.Runner,
+	headBroadcaster httypes.HeadBroadcasterRegistry,
+	lggr logger.Logger,
+) *BlockTrigger {
+	return &BlockTrigger{
+		logger: lggr.Named("BlockTrigger").With(
+			"jobID", jobSpec.ID,
+			"blockInterval", jobSpec.BlockTriggerSpec.BlockInterval,
+		),
+		jobSpec:         jobSpec,
+		pipelineRunner:  pipelineRunner,
+		headBroadcaster: headBroadcaster,
+		blockInterval:   int64(jobSpec.BlockTriggerSpec.BlockInterval),
+		chStop:          make(chan struct{}),
+	}
+}
+
+// Start implements the job.Service interface.
+func (bt *BlockTrigger) Start() error {
+	return bt.StartOnce("BlockTrigger", func() error {
+		latestHead, unsubscribe := bt.headBroadcaster.Subscribe(bt)
+		bt.unsubscribe = unsubscribe
+		if latestHead != nil {
+			bt.mu.Lock()
+			bt.lastHeadNumber = &latestHead.Number
+			bt.mu.Unlock()
+		}
+		return nil
+	})
+}
+
+// Close implements the job.Service interface. It waits for the run in
+// progress, if any, to be cancelled.
+func (bt *BlockTrigger) Close() error {
+	return bt.StopOnce("BlockTrigger", func() error {
+		bt.unsubscribe()
+		// Closed with the lock held, so that no run starts after it
+		bt.mu.Lock()
+		close(bt.chStop)
+		bt.mu.Unlock()
+		bt.wg.Wait()
+		return nil
+	})
+}
+
+// OnNewLongestChain runs the job if the head reaches a multiple of the block
+// interval. Since the head broadcaster may skip heads, the job runs on the
+// first head past a multiple if the head of the multiple itself is skipped.
+// If the previous run is still in progress, the head is skipped.
+func (bt *BlockTrigger) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	if !bt.reachedInterval(head.Number) {
+		return
+	}
+	if bt.running {
+		bt.logger.Warnw("Previous run is still in progress - skipping head", "blockNumber", head.Number, "blockHash", head.Hash)
+		return
+	}
+	select {
+	case <-bt.chStop:
+		return
+	default:
+	}
+
+	bt.running = true
+	bt.wg.Add(1)
+	go func() {
+		defer bt.wg.Done()
+		bt.runPipeline(head)
+		bt.mu.Lock()
+		bt.running = false
+		bt.mu.Unlock()
+	}()
+}
+
+// reachedInterval records the head number, and returns whether the heads
+// crossed a multiple of the block interval since the last one. It must be
+// called with the lock held.
+func (bt *BlockTrigger) reachedInterval(number int64) bool {
+	last := bt.lastHeadNumber
+	bt.lastHeadNumber = &number
+	if last == nil {
+		return number%bt.blockInterval == 0
+	}
+	return number > *last && number/bt.blockInterval > *last/bt.blockInterval
+}
+
+func (bt *BlockTrigger) runPipeline(head *evmtypes.Head) {
+	ctx, cancel := utils.ContextFromChan(bt.chStop)
+	defer cancel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    bt.jobSpec.ID,
+			"externalJobID": bt.jobSpec.ExternalJobID,
+			"name":          bt.jobSpec.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"blockNumber":    head.Number,
+			"blockHash":      head.Hash,
+			"blockTimestamp": head.Timestamp.Unix(),
+		},
+	})
+
+	run := pipeline.NewRun(*bt.jobSpec.PipelineSpec, vars)
+
+	_, err := bt.pipelineRunner.Run(ctx, &run, bt.logger, false, nil)
+	if ctx.Err() != nil {
+		return
+	} else if err != nil {
+		bt.logger.Errorw("Error executing new run", "err", err, "blockNumber", head.Number)
+	}
+}
@@ -0,0 +1,126 @@
+package blocktrigger_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	htmocks "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func newHead(number int64) *evmtypes.Head {
+	return &evmtypes.Head{
+		Number:    number,
+		Hash:      common.BigToHash(big.NewInt(number)),
+		Timestamp: time.Unix(1600000000+number, 0),
+	}
+}
+
+func newBlockTrigger(t *testing.T, blockInterval uint32, latestHead *evmtypes.Head, runner pipeline.Runner) *blocktrigger.BlockTrigger {
+	t.Helper()
+
+	hb := new(htmocks.HeadBroadcaster)
+	hb.Test(t)
+	bt := blocktrigger.NewBlockTrigger(job.Job{
+		ID:               1,
+		Type:             job.BlockTrigger,
+		BlockTriggerSpec: &job.BlockTriggerSpec{BlockInterval: blockInterval},
+		PipelineSpec:     &pipeline.Spec{},
+	}, runner, hb, logger.TestLogger(t))
+	hb.On("Subscribe", bt).Return(latestHead, func() {}).Once()
+
+	require.NoError(t, bt.Start())
+	t.Cleanup(func() { assert.NoError(t, bt.Close()) })
+	return bt
+}
+
+func TestBlockTrigger_RunsEveryBlockInterval(t *testing.T) {
+	runner := new(pipelinemocks.Runner)
+	runner.Test(t)
+
+	runs := make(chan pipeline.Run, 10)
+	runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+		runs <- *args.Get(1).(*pipeline.Run)
+	})
+
+	bt := newBlockTrigger(t, 3, newHead(10), runner)
+
+	jobRunBlockNumber := func() int64 {
+		select {
+		case run := <-runs:
+			jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+			return jobRun["blockNumber"].(int64)
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a pipeline run")
+		}
+		return 0
+	}
+
+	bt.OnNewLongestChain(context.Background(), newHead(11))
+	bt.OnNewLongestChain(context.Background(), newHead(12))
+	assert.Equal(t, int64(12), jobRunBlockNumber())
+
+	// Runs on the first head past a skipped multiple
+	bt.OnNewLongestChain(context.Background(), newHead(13))
+	bt.OnNewLongestChain(context.Background(), newHead(16))
+	assert.Equal(t, int64(16), jobRunBlockNumber())
+
+	t.Run("exposes the block in the vars", func(t *testing.T) {
+		bt.OnNewLongestChain(context.Background(), newHead(18))
+
+		run := <-runs
+		jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+		assert.Equal(t, map[string]interface{}{
+			"blockNumber":    int64(18),
+			"blockHash":      newHead(18).Hash,
+			"blockTimestamp": int64(1600000018),
+		}, jobRun)
+	})
+
+	assert.Empty(t, runs)
+}
+
+func TestBlockTrigger_SkipsHeadsWhileRunning(t *testing.T) {
+	runner := new(pipelinemocks.Runner)
+	runner.Test(t)
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+		started <- struct{}{}
+		<-finish
+	})
+
+	bt := newBlockTrigger(t, 1, nil, runner)
+
+	bt.OnNewLongestChain(context.Background(), newHead(1))
+	<-started
+	bt.OnNewLongestChain(context.Background(), newHead(2))
+	close(finish)
+
+	// The next head runs the job once the previous run is done
+	number := int64(2)
+	require.Eventually(t, func() bool {
+		number++
+		bt.OnNewLongestChain(context.Background(), newHead(number))
+		select {
+		case <-started:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+	runner.AssertNumberOfCalls(t, "Run", 2)
+}
@@ -0,0 +1,50 @@
+package blocktrigger
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type Delegate struct {
+	pipelineRunner pipeline.Runner
+	chainSet       evm.ChainSet
+	lggr           logger.Logger
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(pipelineRunner pipeline.Runner, chainSet evm.ChainSet, lggr logger.Logger) *Delegate {
+	return &Delegate{
+		pipelineRunner: pipelineRunner,
+		chainSet:       chainSet,
+		lggr:           lggr,
+	}
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.BlockTrigger
+}
+
+func (Delegate) AfterJobCreated(spec job.Job)  {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// ServicesForSpec returns the head subscriber running a blocktrigger job
+func (d *Delegate) ServicesForSpec(spec job.Job) ([]job.Service, error) {
+	// TODO: we need to fill these out manually, find a better fix
+	spec.PipelineSpec.JobName = spec.Name.ValueOrZero()
+	spec.PipelineSpec.JobID = spec.ID
+
+	if spec.BlockTriggerSpec == nil {
+		return nil, errors.Errorf("services.Delegate expects a *jobSpec.BlockTriggerSpec to be present, got %v", spec)
+	}
+	chain, err := d.chainSet.Get(spec.BlockTriggerSpec.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	return []job.Service{NewBlockTrigger(spec, d.pipelineRunner, chain.HeadBroadcaster(), d.lggr)}, nil
+}
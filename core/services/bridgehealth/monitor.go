@@ -0,0 +1,289 @@
+package bridgehealth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// probeInterval is how often every bridge is probed.
+	probeInterval = time.Minute
+	// probeTimeout bounds probes of bridges that do not set a timeout.
+	probeTimeout = 10 * time.Second
+	// bridgesPageSize is the number of bridges loaded at a time.
+	bridgesPageSize = 100
+)
+
+var promBridgeUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "bridge_up",
+	Help: "Whether the last health probe of the bridge succeeded (1) or failed (0)",
+},
+	[]string{"bridge_name"},
+)
+
+// State is the health of a bridge according to its health probes.
+type State string
+
+const (
+	// StateUnknown is the state of bridges that have not been probed yet.
+	StateUnknown State = "unknown"
+	// StateUp is the state of bridges whose last probe succeeded.
+	StateUp State = "up"
+	// StateDown is the state of bridges whose last probe failed.
+	StateDown State = "down"
+)
+
+// Status is the health of a bridge, combining the result of the latest
+// health probe with stats of the requests made by bridge tasks.
+type Status struct {
+	State State
+	// LastProbedAt is when the bridge was last probed, if it has been.
+	LastProbedAt *time.Time
+	// LastProbeError is the error of the last probe, if it failed.
+	LastProbeError string
+	// ConsecutiveProbeFailures is the number of probes that failed since the
+	// last successful one.
+	ConsecutiveProbeFailures uint32
+	// Requests holds stats of the most recent requests made by bridge tasks.
+	Requests pipeline.BridgeRequestStats
+}
+
+// JobORM finds the jobs using a bridge.
+type JobORM interface {
+	FindJobIDsWithBridge(name string) ([]int32, error)
+}
+
+//go:generate mockery --name Monitor --output ./mocks/ --case=underscore
+
+// Monitor periodically probes every bridge, and reports bridges that are down
+// while used by jobs as unhealthy to the health checker.
+type Monitor interface {
+	services.Service
+
+	// Status returns the health of the named bridge.
+	Status(name bridges.TaskType) Status
+}
+
+type probeResult struct {
+	probedAt            time.Time
+	err                 error
+	consecutiveFailures uint32
+}
+
+type monitor struct {
+	bridgeORM  bridges.ORM
+	jobORM     JobORM
+	httpClient *http.Client
+	lggr       logger.Logger
+
+	mu     sync.RWMutex
+	probes map[bridges.TaskType]probeResult
+	// downInUse lists the bridges that are down and used by jobs, as of the
+	// last round of probes.
+	downInUse []string
+
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+
+	utils.StartStopOnce
+}
+
+var _ Monitor = (*monitor)(nil)
+
+// NewMonitor creates a Monitor probing the bridges in bridgeORM.
+func NewMonitor(bridgeORM bridges.ORM, jobORM JobORM, lggr logger.Logger) Monitor {
+	return &monitor{
+		bridgeORM:  bridgeORM,
+		jobORM:     jobORM,
+		httpClient: &http.Client{},
+		lggr:       lggr.Named("BridgeHealthMonitor"),
+		probes:     make(map[bridges.TaskType]probeResult),
+		chStop:     make(chan struct{}),
+	}
+}
+
+// Start starts probing bridges.
+func (m *monitor) Start() error {
+	return m.StartOnce("BridgeHealthMonitor", func() error {
+		m.wgDone.Add(1)
+		go m.run()
+		return nil
+	})
+}
+
+// Close stops probing bridges.
+func (m *monitor) Close() error {
+	return m.StopOnce("BridgeHealthMonitor", func() error {
+		close(m.chStop)
+		m.wgDone.Wait()
+		return nil
+	})
+}
+
+// Healthy returns an error naming the bridges that are down and used by jobs.
+func (m *monitor) Healthy() error {
+	if err := m.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.downInUse) > 0 {
+		return errors.Errorf("bridges used by jobs are down: %s", strings.Join(m.downInUse, ", "))
+	}
+	return nil
+}
+
+func (m *monitor) Status(name bridges.TaskType) Status {
+	status := Status{
+		State:    StateUnknown,
+		Requests: pipeline.GetBridgeRequestStats(name.String()),
+	}
+	m.mu.RLock()
+	probe, exists := m.probes[name]
+	m.mu.RUnlock()
+	if !exists {
+		return status
+	}
+	status.LastProbedAt = &probe.probedAt
+	status.ConsecutiveProbeFailures = probe.consecutiveFailures
+	if probe.err != nil {
+		status.State = StateDown
+		status.LastProbeError = probe.err.Error()
+	} else {
+		status.State = StateUp
+	}
+	return status
+}
+
+func (m *monitor) run() {
+	defer m.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(m.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	for {
+		m.probeAll(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-m.chStop:
+			return
+		}
+	}
+}
+
+// probeAll probes every bridge concurrently, then updates the list of bridges
+// that are down and used by jobs.
+func (m *monitor) probeAll(ctx context.Context) {
+	var bts []bridges.BridgeType
+	for offset := 0; ; offset += bridgesPageSize {
+		page, count, err := m.bridgeORM.BridgeTypes(offset, bridgesPageSize)
+		if err != nil {
+			m.lggr.Errorw("Failed to load bridges", "err", err)
+			return
+		}
+		bts = append(bts, page...)
+		if len(page) == 0 || len(bts) >= count {
+			break
+		}
+	}
+
+	results := make([]error, len(bts))
+	var wg sync.WaitGroup
+	wg.Add(len(bts))
+	for i := range bts {
+		go func(i int) {
+			defer wg.Done()
+			results[i] = m.probe(ctx, bts[i])
+		}(i)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	now := time.Now()
+	probes := make(map[bridges.TaskType]probeResult, len(bts))
+	var down []string
+	m.mu.RLock()
+	for i, bt := range bts {
+		result := probeResult{probedAt: now, err: results[i]}
+		if result.err != nil {
+			result.consecutiveFailures = m.probes[bt.Name].consecutiveFailures + 1
+			down = append(down, bt.Name.String())
+			promBridgeUp.WithLabelValues(bt.Name.String()).Set(0)
+		} else {
+			promBridgeUp.WithLabelValues(bt.Name.String()).Set(1)
+		}
+		probes[bt.Name] = result
+	}
+	m.mu.RUnlock()
+
+	var downInUse []string
+	for _, name := range down {
+		jobIDs, err := m.jobORM.FindJobIDsWithBridge(name)
+		if err != nil {
+			m.lggr.Errorw("Failed to find jobs using bridge", "bridge", name, "err", err)
+			continue
+		}
+		if len(jobIDs) > 0 {
+			m.lggr.Warnw("Bridge used by jobs is down", "bridge", name, "jobIDs", jobIDs, "err", probes[bridges.TaskType(name)].err)
+			downInUse = append(downInUse, name)
+		}
+	}
+	sort.Strings(downInUse)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for name := range m.probes {
+		if _, exists := probes[name]; !exists {
+			promBridgeUp.DeleteLabelValues(name.String())
+		}
+	}
+	m.probes = probes
+	m.downInUse = downInUse
+}
+
+// probe checks that the bridge responds to a GET request without a server
+// error. Any other response, including a client error for the unsupported
+// method, shows that the bridge is up.
+func (m *monitor) probe(ctx context.Context, bt bridges.BridgeType) error {
+	timeout := probeTimeout
+	if bt.Timeout.Duration() > 0 {
+		timeout = bt.Timeout.Duration()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bt.URL.String(), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create probe request")
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "probe request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("probe got status code %d", resp.StatusCode)
+	}
+	return nil
+}
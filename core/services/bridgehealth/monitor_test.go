@@ -0,0 +1,72 @@
+package bridgehealth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	bridgesMocks "github.com/smartcontractkit/chainlink/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type jobORMStub map[string][]int32
+
+func (s jobORMStub) FindJobIDsWithBridge(name string) ([]int32, error) {
+	return s[name], nil
+}
+
+func newBridge(t *testing.T, name string, rawURL string) bridges.BridgeType {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	return bridges.BridgeType{Name: bridges.TaskType(name), URL: models.WebURL(*u)}
+}
+
+func TestMonitor_ProbeAll(t *testing.T) {
+	t.Parallel()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Bridges usually only accept POST requests, which still shows they are up
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer up.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	bts := []bridges.BridgeType{
+		newBridge(t, "up", up.URL),
+		newBridge(t, "failing", failing.URL),
+		newBridge(t, "unused", failing.URL),
+	}
+	bridgeORM := new(bridgesMocks.ORM)
+	bridgeORM.On("BridgeTypes", 0, bridgesPageSize).Return(bts, len(bts), nil)
+	m := NewMonitor(bridgeORM, jobORMStub{"failing": {1, 2}, "up": {3}}, logger.TestLogger(t)).(*monitor)
+	// Mark the monitor as started without running its probe loop
+	require.NoError(t, m.StartOnce("BridgeHealthMonitor", func() error { return nil }))
+
+	assert.Equal(t, StateUnknown, m.Status("other").State)
+
+	m.probeAll(context.Background())
+	m.probeAll(context.Background())
+
+	status := m.Status("up")
+	assert.Equal(t, StateUp, status.State)
+	assert.NotNil(t, status.LastProbedAt)
+	assert.Empty(t, status.LastProbeError)
+	assert.Equal(t, uint32(0), status.ConsecutiveProbeFailures)
+
+	status = m.Status("failing")
+	assert.Equal(t, StateDown, status.State)
+	assert.Contains(t, status.LastProbeError, "503")
+	assert.Equal(t, uint32(2), status.ConsecutiveProbeFailures)
+
+	assert.EqualError(t, m.Healthy(), "bridges used by jobs are down: failing")
+}
@@ -17,12 +17,14 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"go.uber.org/multierr"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana"
 	pkgterra "github.com/smartcontractkit/chainlink-terra/pkg/terra"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	terratypes "github.com/smartcontractkit/chainlink/core/chains/terra/types"
@@ -30,8 +32,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -88,6 +93,7 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	RerunPipelineRunV2(ctx context.Context, runID int64) (int64, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
@@ -95,8 +101,13 @@ type Application interface {
 	// Feeds
 	GetFeedsService() feeds.Service
 
+	// Bridges
+	GetBridgeHealthMonitor() bridgehealth.Monitor
+
 	// ReplayFromBlock of blocks
 	ReplayFromBlock(chainID *big.Int, number uint64) error
+	// ReplayListeners replays logs to the listeners of a job and/or on a contract only
+	ReplayListeners(chainID *big.Int, opts log.ReplayListenersOpts) error
 
 	// ID is unique to this particular application instance
 	ID() uuid.UUID
@@ -113,6 +124,7 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeHealthMonitor      bridgehealth.Monitor
 	sessionORM               sessions.ORM
 	bptxmORM                 bulletprooftxmanager.ORM
 	FeedsService             feeds.Service
@@ -228,11 +240,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
 		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
 		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), keyStore.Secrets(), keyStore.CSA(), globalLogger)
 		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
 		bptxmORM       = bulletprooftxmanager.NewORM(db, globalLogger, cfg)
 	)
 
+	bridgeHealthMonitor := bridgehealth.NewMonitor(bridgeORM, jobORM, globalLogger)
+	subservices = append(subservices, bridgeHealthMonitor)
+
 	for _, chain := range chains.EVM.Chains() {
 		chain.HeadBroadcaster().Subscribe(promReporter)
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
@@ -261,15 +276,25 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				cfg),
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
+				pipelineORM,
 				externalInitiatorManager,
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				pipelineORM,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				globalLogger,
 				chains.EVM,
 				keyStore.Eth()),
+			job.EVMLog: evmlog.NewDelegate(
+				globalLogger,
+				pipelineRunner,
+				chains.EVM),
+			job.BlockTrigger: blocktrigger.NewDelegate(
+				pipelineRunner,
+				chains.EVM,
+				globalLogger),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
@@ -386,6 +411,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeHealthMonitor:      bridgeHealthMonitor,
 		sessionORM:               sessionORM,
 		bptxmORM:                 bptxmORM,
 		FeedsService:             feedsService,
@@ -686,6 +712,64 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// RerunPipelineRunV2 executes the pipeline of a finished run again with the
+// inputs of that run, and returns the ID of the new run. The new run uses the
+// pipeline spec of the original run, not a newer spec of its job, and is
+// linked to the original through RerunOf.
+func (app *ChainlinkApplication) RerunPipelineRunV2(ctx context.Context, runID int64) (int64, error) {
+	orig, err := app.pipelineORM.FindRun(runID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "run ID %v", runID)
+	}
+	if !orig.State.Finished() {
+		return 0, errors.Errorf("run %v has not finished yet", runID)
+	}
+
+	jbs, err := app.jobORM.FindJobsByPipelineSpecIDs([]int32{orig.PipelineSpecID})
+	if err != nil {
+		return 0, err
+	}
+	if len(jbs) == 0 {
+		return 0, errors.Errorf("no job found for run %v", runID)
+	}
+	jb := jbs[0]
+	spec := orig.PipelineSpec
+	spec.JobID = jb.ID
+	spec.JobName = jb.Name.ValueOrZero()
+
+	p, err := spec.Pipeline()
+	if err != nil {
+		return 0, err
+	}
+	// The stored inputs also hold the outputs of the tasks that ran, which
+	// must not leak into the new run.
+	vars := make(map[string]interface{})
+	if inputs, ok := orig.Inputs.Val.(map[string]interface{}); ok {
+		for k, v := range inputs {
+			vars[k] = v
+		}
+	}
+	for _, task := range p.Tasks {
+		// Re-running would broadcast the transactions of the original run again
+		if task.Type() == pipeline.TaskTypeETHTx {
+			return 0, errors.Errorf("runs of pipelines with %s tasks cannot be re-run", pipeline.TaskTypeETHTx)
+		}
+		delete(vars, task.DotID())
+	}
+
+	run := pipeline.NewRun(spec, pipeline.NewVarsFrom(vars))
+	run.RerunOf = null.IntFrom(orig.ID)
+
+	lggr := app.logger.With("jobID", jb.ID, "rerunOf", orig.ID)
+	if _, err = app.pipelineRunner.Run(ctx, &run, lggr, true, nil); err != nil {
+		return 0, err
+	}
+	if run.ID == 0 {
+		return 0, errors.Errorf("re-run of run %v failed early and was not saved", runID)
+	}
+	return run.ID, nil
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
@@ -698,6 +782,10 @@ func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
 
+func (app *ChainlinkApplication) GetBridgeHealthMonitor() bridgehealth.Monitor {
+	return app.bridgeHealthMonitor
+}
+
 func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	chain, err := app.Chains.EVM.Get(chainID)
 	if err != nil {
@@ -707,6 +795,14 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+func (app *ChainlinkApplication) ReplayListeners(chainID *big.Int, opts log.ReplayListenersOpts) error {
+	chain, err := app.Chains.EVM.Get(chainID)
+	if err != nil {
+		return err
+	}
+	return chain.LogBroadcaster().ReplayListeners(opts)
+}
+
 // GetChains returns Chains.
 func (app *ChainlinkApplication) GetChains() Chains {
 	return app.Chains
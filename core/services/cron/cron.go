@@ -1,8 +1,12 @@
 package cron
 
 import (
-	"fmt"
+	"database/sql"
+	mrand "math/rand"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -11,19 +15,42 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// maxCatchUpRuns is the maximum number of missed schedules run when a job
+// catches up, so that a job with a frequent schedule does not flood the node
+// after a long downtime. The most recent missed schedules are run. Jobs
+// limiting their concurrent runs catch up on at most as many schedules as
+// can be in progress and queued at once, see catchUpLimit.
+const maxCatchUpRuns = 100
+
+// maxQueuedRuns is the maximum number of scheduled runs waiting for a run in
+// progress to finish, so that a job whose runs take longer than its schedule
+// does not build up an ever growing backlog. The oldest queued runs are
+// dropped.
+const maxQueuedRuns = 10
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
-	cronRunner     *cron.Cron
+	schedule       cron.Schedule
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
-	chStop         chan struct{}
+	pipelineORM    pipeline.ORM
+
+	mu      sync.Mutex
+	running uint32
+	// queued are the scheduled times of the runs waiting for a run in
+	// progress to finish, oldest first
+	queued []time.Time
+
+	wg     sync.WaitGroup
+	chStop chan struct{}
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	pipelineORM pipeline.ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -31,11 +58,17 @@ func NewCronFromJobSpec(
 		"schedule", jobSpec.CronSpec.CronSchedule,
 	)
 
+	schedule, err := cronParser().Parse(jobSpec.CronSpec.CronSchedule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron schedule '%v'", jobSpec.CronSpec.CronSchedule)
+	}
+
 	return &Cron{
-		cronRunner:     cronRunner(),
+		schedule:       schedule,
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		pipelineORM:    pipelineORM,
 		chStop:         make(chan struct{}),
 	}, nil
 }
@@ -44,12 +77,8 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start() error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
-		return err
-	}
-	cr.cronRunner.Start()
+	cr.wg.Add(1)
+	go cr.run()
 	return nil
 }
 
@@ -57,11 +86,162 @@ func (cr *Cron) Start() error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	close(cr.chStop)
+	cr.wg.Wait()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+func (cr *Cron) run() {
+	defer cr.wg.Done()
+
+	now := time.Now()
+	if cr.jobSpec.CronSpec.CatchUp {
+		cr.catchUp(now)
+	}
+
+	for next := cr.schedule.Next(now); !next.IsZero(); next = cr.schedule.Next(time.Now()) {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-cr.chStop:
+			timer.Stop()
+			return
+		case <-timer.C:
+			cr.scheduleRun(next)
+		}
+	}
+	// The schedule has no more times, e.g. it is for February 30th
+	<-cr.chStop
+}
+
+// catchUp schedules the runs missed since the last successful run.
+func (cr *Cron) catchUp(now time.Time) {
+	lastRun, err := cr.pipelineORM.FindLatestCompletedRun(cr.jobSpec.PipelineSpec.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		cr.logger.Debug("No successful run to catch up from")
+		return
+	} else if err != nil {
+		cr.logger.Errorw("Failed to load the last successful run - not catching up", "err", err)
+		return
+	}
+
+	limit := cr.catchUpLimit()
+	var missed []time.Time
+	dropped := 0
+	for tick := cr.schedule.Next(scheduledTime(lastRun)); !tick.After(now); tick = cr.schedule.Next(tick) {
+		if len(missed) == limit {
+			missed = missed[1:]
+			dropped++
+		}
+		missed = append(missed, tick)
+	}
+	if len(missed) == 0 {
+		return
+	}
+	if dropped > 0 {
+		cr.logger.Warnw("Too many missed schedules - only running the most recent ones",
+			"missed", dropped+len(missed), "limit", limit, "maxConcurrentRuns", cr.jobSpec.CronSpec.MaxConcurrentRuns)
+	}
+	cr.logger.Infow("Catching up on missed schedules", "count", len(missed), "lastRunID", lastRun.ID)
+	for _, tick := range missed {
+		cr.scheduleRun(tick)
+	}
+}
+
+// catchUpLimit returns the maximum number of missed schedules run when
+// catching up. Jobs limiting their concurrent runs would skip, or drop from
+// the queue, the schedules over the limit anyway.
+func (cr *Cron) catchUpLimit() int {
+	spec := cr.jobSpec.CronSpec
+	if spec.MaxConcurrentRuns == 0 {
+		return maxCatchUpRuns
+	}
+	limit := int(spec.MaxConcurrentRuns)
+	if spec.ConcurrencyPolicy == job.CronConcurrencyQueue {
+		limit += maxQueuedRuns
+	}
+	if limit > maxCatchUpRuns {
+		return maxCatchUpRuns
+	}
+	return limit
+}
+
+// scheduleRun starts the run scheduled at the given time, or applies the
+// concurrency policy if the maximum number of runs are in progress.
+func (cr *Cron) scheduleRun(scheduledAt time.Time) {
+	spec := cr.jobSpec.CronSpec
+
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if spec.MaxConcurrentRuns > 0 && cr.running >= spec.MaxConcurrentRuns {
+		if spec.ConcurrencyPolicy == job.CronConcurrencyQueue {
+			if len(cr.queued) == maxQueuedRuns {
+				cr.logger.Warnw("Too many queued runs - dropping the oldest one",
+					"droppedScheduledAt", cr.queued[0], "maxQueuedRuns", maxQueuedRuns)
+				cr.queued = cr.queued[1:]
+			}
+			cr.queued = append(cr.queued, scheduledAt)
+			return
+		}
+		cr.logger.Warnw("Maximum number of concurrent runs in progress - skipping scheduled run",
+			"scheduledAt", scheduledAt, "maxConcurrentRuns", spec.MaxConcurrentRuns)
+		return
+	}
+	cr.running++
+
+	cr.wg.Add(1)
+	go func() {
+		defer cr.wg.Done()
+		for next := true; next; scheduledAt, next = cr.nextQueued() {
+			if cr.waitJitter() {
+				cr.runPipeline(scheduledAt)
+			}
+		}
+	}()
+}
+
+// nextQueued takes the oldest queued run. It returns false, and the run in
+// progress is done, if there is none or the job is stopping.
+func (cr *Cron) nextQueued() (time.Time, bool) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	select {
+	case <-cr.chStop:
+		// The queued runs are dropped when the job stops
+		cr.queued = nil
+	default:
+	}
+	if len(cr.queued) == 0 {
+		cr.running--
+		return time.Time{}, false
+	}
+	scheduledAt := cr.queued[0]
+	cr.queued = cr.queued[1:]
+	return scheduledAt, true
+}
+
+// waitJitter waits for a random delay up to the jitter of the spec. It
+// returns false if the job is stopped.
+func (cr *Cron) waitJitter() bool {
+	jitter := cr.jobSpec.CronSpec.Jitter
+	if jitter <= 0 {
+		select {
+		case <-cr.chStop:
+			return false
+		default:
+			return true
+		}
+	}
+	// #nosec
+	delay := time.Duration(mrand.Int63n(int64(jitter)))
+	select {
+	case <-time.After(delay):
+		return true
+	case <-cr.chStop:
+		return false
+	}
+}
+
+func (cr *Cron) runPipeline(scheduledAt time.Time) {
 	ctx, cancel := utils.ContextFromChan(cr.chStop)
 	defer cancel()
 
@@ -72,7 +252,8 @@ func (cr *Cron) runPipeline() {
 			"name":          cr.jobSpec.Name.ValueOrZero(),
 		},
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta":               map[string]interface{}{},
+			"scheduledTimestamp": scheduledAt.Unix(),
 		},
 	})
 
@@ -84,6 +265,21 @@ func (cr *Cron) runPipeline() {
 	}
 }
 
-func cronRunner() *cron.Cron {
-	return cron.New(cron.WithSeconds())
+// scheduledTime returns the time a run was scheduled at. Runs from before
+// the scheduled time was recorded fall back to their creation time.
+func scheduledTime(run pipeline.Run) time.Time {
+	inputs, _ := run.Inputs.Val.(map[string]interface{})
+	jobRun, _ := inputs["jobRun"].(map[string]interface{})
+	switch ts := jobRun["scheduledTimestamp"].(type) {
+	case float64:
+		return time.Unix(int64(ts), 0)
+	case int64:
+		return time.Unix(ts, 0)
+	default:
+		return run.CreatedAt
+	}
+}
+
+func cronParser() cron.Parser {
+	return cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
 }
@@ -0,0 +1,72 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestCron_QueueDropsOldest(t *testing.T) {
+	t.Parallel()
+
+	spec := job.Job{
+		Type:          job.Cron,
+		SchemaVersion: 1,
+		CronSpec: &job.CronSpec{
+			CronSchedule:      "@every 1h",
+			MaxConcurrentRuns: 1,
+			ConcurrencyPolicy: job.CronConcurrencyQueue,
+		},
+		PipelineSpec: &pipeline.Spec{ID: 1},
+	}
+	cr, err := NewCronFromJobSpec(spec, new(pipelinemocks.Runner), new(pipelinemocks.ORM), logger.TestLogger(t))
+	require.NoError(t, err)
+
+	// A run is in progress, so every scheduled run is queued
+	cr.running = 1
+	start := time.Now()
+	for i := 0; i < maxQueuedRuns+2; i++ {
+		cr.scheduleRun(start.Add(time.Duration(i) * time.Hour))
+	}
+
+	require.Len(t, cr.queued, maxQueuedRuns)
+	assert.Equal(t, start.Add(2*time.Hour), cr.queued[0])
+	assert.Equal(t, start.Add(time.Duration(maxQueuedRuns+1)*time.Hour), cr.queued[maxQueuedRuns-1])
+
+	// Stopping drops the queued runs
+	close(cr.chStop)
+	_, next := cr.nextQueued()
+	assert.False(t, next)
+	assert.Empty(t, cr.queued)
+	assert.Equal(t, uint32(0), cr.running)
+}
+
+func TestCron_CatchUpLimit(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		cronSpec job.CronSpec
+		limit    int
+	}{
+		{"unlimited", job.CronSpec{}, maxCatchUpRuns},
+		{"skip", job.CronSpec{MaxConcurrentRuns: 2, ConcurrencyPolicy: job.CronConcurrencySkip}, 2},
+		{"queue", job.CronSpec{MaxConcurrentRuns: 2, ConcurrencyPolicy: job.CronConcurrencyQueue}, 2 + maxQueuedRuns},
+		{"over the maximum", job.CronSpec{MaxConcurrentRuns: 500}, maxCatchUpRuns},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cronSpec.CronSchedule = "@every 1h"
+			cr, err := NewCronFromJobSpec(job.Job{CronSpec: &tt.cronSpec}, nil, nil, logger.TestLogger(t))
+			require.NoError(t, err)
+			assert.Equal(t, tt.limit, cr.catchUpLimit())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cron_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -38,7 +39,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.NewV4(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, orm, lggr)
 
 	err := jobORM.CreateJob(jb)
 	require.NoError(t, err)
@@ -66,7 +67,7 @@ func TestCronV2Schedule(t *testing.T) {
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Return(false, nil).Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, new(pipelinemocks.ORM), logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start()
 	require.NoError(t, err)
@@ -74,3 +75,217 @@ func TestCronV2Schedule(t *testing.T) {
 
 	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 1*time.Second)
 }
+
+func TestCronV2CatchUp(t *testing.T) {
+	t.Parallel()
+
+	// Every hour, so that the job only runs to catch up during the test
+	lastScheduledAt := time.Now().Add(-3*time.Hour - 30*time.Minute).Unix()
+	lastRun := pipeline.Run{
+		ID: 1,
+		Inputs: pipeline.JSONSerializable{Val: map[string]interface{}{
+			"jobRun": map[string]interface{}{"scheduledTimestamp": float64(lastScheduledAt)},
+		}, Valid: true},
+	}
+	newSpec := func(cronSpec job.CronSpec) job.Job {
+		cronSpec.CronSchedule = "@every 1h"
+		cronSpec.CatchUp = true
+		return job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec:      &cronSpec,
+			PipelineSpec:  &pipeline.Spec{ID: 1},
+		}
+	}
+	newService := func(t *testing.T, spec job.Job, runner pipeline.Runner) *cron.Cron {
+		orm := new(pipelinemocks.ORM)
+		orm.Test(t)
+		orm.On("FindLatestCompletedRun", int32(1)).Return(lastRun, nil).Once()
+
+		service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, service.Start())
+		t.Cleanup(func() {
+			assert.NoError(t, service.Close())
+			orm.AssertExpectations(t)
+		})
+		return service
+	}
+	scheduledTimestamp := func(run *pipeline.Run) int64 {
+		jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+		return jobRun["scheduledTimestamp"].(int64)
+	}
+	missed := []int64{lastScheduledAt + 3600, lastScheduledAt + 7200, lastScheduledAt + 10800}
+
+	t.Run("runs the missed schedules", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		runner.Test(t)
+		scheduled := make(chan int64, 3)
+		runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+			scheduled <- scheduledTimestamp(args.Get(1).(*pipeline.Run))
+		}).Times(3)
+
+		newService(t, newSpec(job.CronSpec{Jitter: 10 * time.Millisecond}), runner)
+
+		var timestamps []int64
+		for range missed {
+			select {
+			case ts := <-scheduled:
+				timestamps = append(timestamps, ts)
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected a run")
+			}
+		}
+		assert.ElementsMatch(t, missed, timestamps)
+	})
+
+	t.Run("only runs the most recent schedules that can be in progress", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		runner.Test(t)
+		started := make(chan struct{})
+		finish := make(chan struct{})
+		runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+			assert.Equal(t, missed[2], scheduledTimestamp(args.Get(1).(*pipeline.Run)))
+			close(started)
+			<-finish
+		}).Once()
+
+		newService(t, newSpec(job.CronSpec{MaxConcurrentRuns: 1, ConcurrencyPolicy: job.CronConcurrencySkip}), runner)
+
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a run")
+		}
+		close(finish)
+	})
+
+	t.Run("queues runs over the maximum", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		runner.Test(t)
+		scheduled := make(chan int64)
+		runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+			scheduled <- scheduledTimestamp(args.Get(1).(*pipeline.Run))
+		}).Times(3)
+
+		newService(t, newSpec(job.CronSpec{MaxConcurrentRuns: 1, ConcurrencyPolicy: job.CronConcurrencyQueue}), runner)
+
+		// Only one run is in progress at once, so they run in order
+		for _, ts := range missed {
+			select {
+			case actual := <-scheduled:
+				assert.Equal(t, ts, actual)
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected a run")
+			}
+		}
+	})
+}
+
+func TestCronV2Queue(t *testing.T) {
+	t.Parallel()
+
+	// Every hour, and catching up on the missed hours to queue runs
+	// during the test
+	newService := func(t *testing.T, missed int, runner pipeline.Runner) *cron.Cron {
+		lastRun := pipeline.Run{
+			ID: 1,
+			Inputs: pipeline.JSONSerializable{Val: map[string]interface{}{
+				"jobRun": map[string]interface{}{"scheduledTimestamp": float64(time.Now().Add(-time.Duration(missed)*time.Hour - 30*time.Minute).Unix())},
+			}, Valid: true},
+		}
+		spec := job.Job{
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec: &job.CronSpec{
+				CronSchedule:      "@every 1h",
+				CatchUp:           true,
+				MaxConcurrentRuns: 1,
+				ConcurrencyPolicy: job.CronConcurrencyQueue,
+			},
+			PipelineSpec: &pipeline.Spec{ID: 1},
+		}
+		orm := new(pipelinemocks.ORM)
+		orm.Test(t)
+		orm.On("FindLatestCompletedRun", int32(1)).Return(lastRun, nil).Once()
+
+		service, err := cron.NewCronFromJobSpec(spec, runner, orm, logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, service.Start())
+		t.Cleanup(func() { orm.AssertExpectations(t) })
+		return service
+	}
+	scheduledTimestamp := func(run *pipeline.Run) int64 {
+		jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+		return jobRun["scheduledTimestamp"].(int64)
+	}
+
+	t.Run("only runs the most recent schedules that can be queued", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		runner.Test(t)
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		scheduled := make(chan int64, 20)
+		// The first run is in progress while the 10 most recent ones are
+		// queued, the 2 oldest are not run
+		runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+			scheduled <- scheduledTimestamp(args.Get(1).(*pipeline.Run))
+			select {
+			case started <- struct{}{}:
+			default:
+			}
+			<-release
+		}).Times(11)
+
+		service := newService(t, 13, runner)
+		t.Cleanup(func() { assert.NoError(t, service.Close()) })
+
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a run")
+		}
+		// Give the catch up time to queue the other runs
+		time.Sleep(100 * time.Millisecond)
+		close(release)
+
+		var timestamps []int64
+		for i := 0; i < 11; i++ {
+			select {
+			case ts := <-scheduled:
+				timestamps = append(timestamps, ts)
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected a run")
+			}
+		}
+		// The 11 most recent ones, in order
+		for i := 1; i < len(timestamps); i++ {
+			assert.Equal(t, timestamps[0]+int64(i)*3600, timestamps[i])
+		}
+		assert.Greater(t, time.Now().Unix()-timestamps[len(timestamps)-1], int64(0))
+		assert.Less(t, time.Now().Unix()-timestamps[len(timestamps)-1], int64(3600))
+	})
+
+	t.Run("drops the queued runs when stopped", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		runner.Test(t)
+		started := make(chan struct{})
+		// Only the run in progress is started, it finishes when the job stops
+		runner.On("Run", mock.Anything, mock.Anything, mock.Anything, false, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+			close(started)
+			<-args.Get(0).(context.Context).Done()
+		}).Once()
+
+		service := newService(t, 3, runner)
+
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected a run")
+		}
+		// Give the catch up time to queue the other runs
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, service.Close())
+		runner.AssertExpectations(t)
+	})
+}
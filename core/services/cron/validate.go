@@ -37,6 +37,16 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
 		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
 	}
+	if spec.Jitter < 0 {
+		return jb, errors.New("jitter must not be negative")
+	}
+	switch spec.ConcurrencyPolicy {
+	case "":
+		spec.ConcurrencyPolicy = job.CronConcurrencySkip
+	case job.CronConcurrencySkip, job.CronConcurrencyQueue:
+	default:
+		return jb, errors.Errorf("unknown concurrencyPolicy %q, must be %q or %q", spec.ConcurrencyPolicy, job.CronConcurrencySkip, job.CronConcurrencyQueue)
+	}
 
 	return jb, nil
 }
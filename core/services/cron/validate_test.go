@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,60 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "concurrency options",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 1 1 * *"
+jitter            = "30s"
+maxConcurrentRuns = 2
+concurrencyPolicy = "queue"
+catchUp           = true
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, 30*time.Second, s.CronSpec.Jitter)
+				assert.Equal(t, uint32(2), s.CronSpec.MaxConcurrentRuns)
+				assert.Equal(t, job.CronConcurrencyQueue, s.CronSpec.ConcurrencyPolicy)
+				assert.True(t, s.CronSpec.CatchUp)
+			},
+		},
+		{
+			name: "defaults to skipping runs over the maximum",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 1 1 * *"
+maxConcurrentRuns = 1
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.CronConcurrencySkip, s.CronSpec.ConcurrencyPolicy)
+				assert.False(t, s.CronSpec.CatchUp)
+			},
+		},
+		{
+			name: "unknown concurrency policy",
+			toml: `
+type              = "cron"
+schemaVersion     = 1
+schedule          = "CRON_TZ=UTC 0 0 1 1 * *"
+concurrencyPolicy = "replace"
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, `unknown concurrencyPolicy "replace", must be "skip" or "queue"`)
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -39,6 +39,10 @@ type (
 
 var _ job.Delegate = (*Delegate)(nil)
 
+// listenerQueueCapacity is the number of logs of each type buffered in memory,
+// which is enough to handle e.g. large log replays
+const listenerQueueCapacity = 100000
+
 func NewDelegate(
 	logger logger.Logger,
 	pipelineRunner pipeline.Runner,
@@ -93,8 +97,8 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 		pipelineRunner:           d.pipelineRunner,
 		pipelineORM:              d.pipelineORM,
 		job:                      jb,
-		mbOracleRequests:         utils.NewHighCapacityMailbox(),
-		mbOracleCancelRequests:   utils.NewHighCapacityMailbox(),
+		oracleRequests:           chain.LogBroadcaster().NewListenerQueue("OracleRequest", jb.ID, listenerQueueCapacity, oracle.ParseLog),
+		oracleCancelRequests:     chain.LogBroadcaster().NewListenerQueue("CancelOracleRequest", jb.ID, listenerQueueCapacity, oracle.ParseLog),
 		minIncomingConfirmations: concreteSpec.MinIncomingConfirmations.Uint32,
 		requesters:               concreteSpec.Requesters,
 		minContractPayment:       concreteSpec.MinContractPayment,
@@ -121,8 +125,8 @@ type listener struct {
 	job                      job.Job
 	runs                     sync.Map
 	shutdownWaitGroup        sync.WaitGroup
-	mbOracleRequests         *utils.Mailbox
-	mbOracleCancelRequests   *utils.Mailbox
+	oracleRequests           *log.ListenerQueue
+	oracleCancelRequests     *log.ListenerQueue
 	minIncomingConfirmations uint32
 	requesters               models.AddressCollection
 	minContractPayment       *assets.Link
@@ -167,6 +171,8 @@ func (l *listener) Close() error {
 		l.runs = sync.Map{}
 
 		close(l.chStop)
+		l.oracleRequests.Close()
+		l.oracleCancelRequests.Close()
 		l.shutdownWaitGroup.Wait()
 
 		return nil
@@ -182,15 +188,9 @@ func (l *listener) HandleLog(lb log.Broadcast) {
 
 	switch log := log.(type) {
 	case *operator_wrapper.OperatorOracleRequest:
-		wasOverCapacity := l.mbOracleRequests.Deliver(lb)
-		if wasOverCapacity {
-			l.logger.Error("OracleRequest log mailbox is over capacity - dropped the oldest log")
-		}
+		l.oracleRequests.Deliver(lb)
 	case *operator_wrapper.OperatorCancelOracleRequest:
-		wasOverCapacity := l.mbOracleCancelRequests.Deliver(lb)
-		if wasOverCapacity {
-			l.logger.Error("CancelOracleRequest log mailbox is over capacity - dropped the oldest log")
-		}
+		l.oracleCancelRequests.Deliver(lb)
 	default:
 		l.logger.Warnf("Unexpected log type %T", log)
 	}
@@ -202,8 +202,8 @@ func (l *listener) processOracleRequests() {
 		case <-l.chStop:
 			l.shutdownWaitGroup.Done()
 			return
-		case <-l.mbOracleRequests.Notify():
-			l.handleReceivedLogs(l.mbOracleRequests)
+		case <-l.oracleRequests.Notify():
+			l.handleReceivedLogs(l.oracleRequests)
 		}
 	}
 }
@@ -214,22 +214,18 @@ func (l *listener) processCancelOracleRequests() {
 		case <-l.chStop:
 			l.shutdownWaitGroup.Done()
 			return
-		case <-l.mbOracleCancelRequests.Notify():
-			l.handleReceivedLogs(l.mbOracleCancelRequests)
+		case <-l.oracleCancelRequests.Notify():
+			l.handleReceivedLogs(l.oracleCancelRequests)
 		}
 	}
 }
 
-func (l *listener) handleReceivedLogs(mailbox *utils.Mailbox) {
+func (l *listener) handleReceivedLogs(queue *log.ListenerQueue) {
 	for {
-		i, exists := mailbox.Retrieve()
+		lb, exists := queue.Retrieve()
 		if !exists {
 			return
 		}
-		lb, ok := i.(log.Broadcast)
-		if !ok {
-			panic(errors.Errorf("DirectRequest: invariant violation, expected log.Broadcast but got %T", lb))
-		}
 		was, err := l.logBroadcaster.WasAlreadyConsumed(lb)
 		if err != nil {
 			l.logger.Errorw("Could not determine if log was already consumed", "error", err)
@@ -77,6 +77,10 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient, LogBroadcaster: broadcaster})
 	lggr := logger.TestLogger(t)
 	orm := pipeline.NewORM(db, lggr, cfg)
+	broadcaster.On("NewListenerQueue", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(name string, jobID int32, capacity uint64, parseLog log.ParseLogFunc) *log.ListenerQueue {
+			return log.NewListenerQueue(name, jobID, cltest.FixtureChainID, capacity, log.OverflowPolicyDropOldest, parseLog, nil, lggr)
+		})
 
 	keyStore := cltest.NewKeyStore(t, db, cfg)
 	jobORM := job.NewORM(db, cc, orm, keyStore, lggr, cfg)
@@ -0,0 +1,269 @@
+package evmlog
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type Delegate struct {
+	logger         logger.Logger
+	pipelineRunner pipeline.Runner
+	chainSet       evm.ChainSet
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+// listenerQueueCapacity is the number of logs buffered in memory for each job
+const listenerQueueCapacity = 100000
+
+func NewDelegate(
+	logger logger.Logger,
+	pipelineRunner pipeline.Runner,
+	chainSet evm.ChainSet,
+) *Delegate {
+	return &Delegate{
+		logger.Named("EVMLog"),
+		pipelineRunner,
+		chainSet,
+	}
+}
+
+func (d *Delegate) JobType() job.Type {
+	return job.EVMLog
+}
+
+func (Delegate) AfterJobCreated(spec job.Job)  {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// ServicesForSpec returns the log listener service for an evmlog job
+func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
+	if jb.EVMLogSpec == nil {
+		return nil, errors.Errorf("EVMLog: evmlog.Delegate expects a *job.EVMLogSpec to be present, got %v", jb)
+	}
+	spec := jb.EVMLogSpec
+	chain, err := d.chainSet.Get(spec.EVMChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+	event, err := pipeline.ParseETHABIEvent(spec.EventABI)
+	if err != nil {
+		return nil, errors.Wrap(err, "EVMLog: invalid eventABI")
+	}
+	filters, err := topicValueFilters(event, spec.TopicFilters)
+	if err != nil {
+		return nil, errors.Wrap(err, "EVMLog")
+	}
+
+	minIncomingConfirmations := chain.Config().MinIncomingConfirmations()
+	if spec.MinIncomingConfirmations.Valid {
+		minIncomingConfirmations = spec.MinIncomingConfirmations.Uint32
+	}
+
+	logListener := &listener{
+		logger: d.logger.With(
+			"contract", spec.ContractAddress.Address().String(),
+			"event", event.Sig,
+			"jobName", jb.PipelineSpec.JobName,
+			"jobID", jb.PipelineSpec.JobID,
+			"externalJobID", jb.ExternalJobID,
+		),
+		logBroadcaster:           chain.LogBroadcaster(),
+		pipelineRunner:           d.pipelineRunner,
+		job:                      jb,
+		event:                    event,
+		filters:                  filters,
+		minIncomingConfirmations: minIncomingConfirmations,
+		chStop:                   make(chan struct{}),
+	}
+	logListener.logs = chain.LogBroadcaster().NewListenerQueue("EVMLog", jb.ID, listenerQueueCapacity, logListener.parseLog)
+
+	// With the log poller enabled, also store the logs of the event so that
+	// they can be queried later. The filter is merged again whenever the job
+	// is started, as the log poller only keeps it in memory.
+	if logPoller := chain.LogPoller(); logPoller != nil {
+		logPoller.MergeFilter([]common.Hash{event.ID}, []common.Address{spec.ContractAddress.Address()})
+	}
+
+	return []job.Service{logListener}, nil
+}
+
+// decodedLog is a log of the event of an evmlog job, decoded with its ABI
+type decodedLog struct {
+	raw    types.Log
+	fields map[string]interface{}
+}
+
+var _ generated.AbigenLog = &decodedLog{}
+
+func (d *decodedLog) Topic() common.Hash {
+	return d.raw.Topics[0]
+}
+
+var (
+	_ log.Listener = &listener{}
+	_ job.Service  = &listener{}
+)
+
+type listener struct {
+	logger                   logger.Logger
+	logBroadcaster           log.Broadcaster
+	pipelineRunner           pipeline.Runner
+	job                      job.Job
+	event                    abi.Event
+	filters                  [][]log.Topic
+	minIncomingConfirmations uint32
+	logs                     *log.ListenerQueue
+	shutdownWaitGroup        sync.WaitGroup
+	chStop                   chan struct{}
+	utils.StartStopOnce
+}
+
+// Start complies with job.Service
+func (l *listener) Start() error {
+	return l.StartOnce("EVMLogListener", func() error {
+		unsubscribeLogs := l.logBroadcaster.Register(l, log.ListenerOpts{
+			Contract: l.job.EVMLogSpec.ContractAddress.Address(),
+			ParseLog: l.parseLog,
+			LogsWithTopics: map[common.Hash][][]log.Topic{
+				l.event.ID: l.filters,
+			},
+			MinIncomingConfirmations: l.minIncomingConfirmations,
+		})
+		l.shutdownWaitGroup.Add(2)
+		go l.processLogs()
+
+		go func() {
+			<-l.chStop
+			unsubscribeLogs()
+			l.shutdownWaitGroup.Done()
+		}()
+
+		return nil
+	})
+}
+
+// Close complies with job.Service
+func (l *listener) Close() error {
+	return l.StopOnce("EVMLogListener", func() error {
+		close(l.chStop)
+		l.logs.Close()
+		l.shutdownWaitGroup.Wait()
+
+		return nil
+	})
+}
+
+// parseLog decodes a log of the event of the job. Logs of another event with
+// the same signature but different indexed arguments fail to decode, and are
+// skipped by the log broadcaster.
+func (l *listener) parseLog(rawLog types.Log) (generated.AbigenLog, error) {
+	if len(rawLog.Topics) == 0 || rawLog.Topics[0] != l.event.ID {
+		return nil, errors.Errorf("log is not a %s event", l.event.Name)
+	}
+	fields, err := pipeline.DecodeETHABIEventLog(l.event, rawLog.Data, rawLog.Topics)
+	if err != nil {
+		return nil, err
+	}
+	return &decodedLog{rawLog, fields}, nil
+}
+
+// HandleLog complies with log.Listener
+func (l *listener) HandleLog(lb log.Broadcast) {
+	if _, ok := lb.DecodedLog().(*decodedLog); !ok {
+		l.logger.Warnf("Unexpected log type %T", lb.DecodedLog())
+		return
+	}
+	l.logs.Deliver(lb)
+}
+
+func (l *listener) processLogs() {
+	for {
+		select {
+		case <-l.chStop:
+			l.shutdownWaitGroup.Done()
+			return
+		case <-l.logs.Notify():
+			l.handleReceivedLogs()
+		}
+	}
+}
+
+func (l *listener) handleReceivedLogs() {
+	for {
+		lb, exists := l.logs.Retrieve()
+		if !exists {
+			return
+		}
+		was, err := l.logBroadcaster.WasAlreadyConsumed(lb)
+		if err != nil {
+			l.logger.Errorw("Could not determine if log was already consumed", "error", err)
+			continue
+		} else if was {
+			continue
+		}
+
+		decoded, ok := lb.DecodedLog().(*decodedLog)
+		if !ok {
+			l.logger.Warnf("Unexpected log type %T", lb.DecodedLog())
+			continue
+		}
+		l.handleLog(decoded, lb)
+	}
+}
+
+func (l *listener) handleLog(decoded *decodedLog, lb log.Broadcast) {
+	l.logger.Debugw("Log received", "blockNumber", decoded.raw.BlockNumber, "txHash", decoded.raw.TxHash)
+
+	ctx, cancel := utils.ContextFromChan(l.chStop)
+	defer cancel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    l.job.ID,
+			"externalJobID": l.job.ExternalJobID,
+			"name":          l.job.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"logBlockHash":   decoded.raw.BlockHash,
+			"logBlockNumber": decoded.raw.BlockNumber,
+			"logTxHash":      decoded.raw.TxHash,
+			"logAddress":     decoded.raw.Address,
+			"logTopics":      decoded.raw.Topics,
+			"logData":        decoded.fields,
+		},
+	})
+	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
+	_, err := l.pipelineRunner.Run(ctx, &run, l.logger, true, func(tx pg.Queryer) error {
+		l.markLogConsumed(lb, pg.WithQueryer(tx))
+		return nil
+	})
+	if ctx.Err() != nil {
+		return
+	} else if err != nil {
+		l.logger.Errorw("Failed executing run", "err", err)
+	}
+}
+
+func (l *listener) markLogConsumed(lb log.Broadcast, qopts ...pg.QOpt) {
+	if err := l.logBroadcaster.MarkConsumed(lb, qopts...); err != nil {
+		l.logger.Errorw("Unable to mark log consumed", "err", err, "log", lb.String())
+	}
+}
+
+// JobID complies with log.Listener
+func (l *listener) JobID() int32 {
+	return l.job.ID
+}
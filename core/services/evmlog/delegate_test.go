@@ -0,0 +1,155 @@
+package evmlog_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmconfigmocks "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	logmocks "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
+	logpollermocks "github.com/smartcontractkit/chainlink/core/chains/evm/logpoller/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/evmlog"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const transferABI = "Transfer(address indexed from, address indexed to, uint256 value)"
+
+var (
+	transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	fromAddress   = common.HexToAddress("0xaaaa1F8ee20f5565510B84f9353F1E333E753B7a")
+	toAddress     = common.HexToAddress("0xbbbb70F0e81C6F3430dfdC9fa02fB22BdD818C4e")
+)
+
+func TestDelegate_ServicesForSpec(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	evmChainID := big.NewInt(42)
+
+	cfg := new(evmconfigmocks.ChainScopedConfig)
+	cfg.Test(t)
+	cfg.On("MinIncomingConfirmations").Return(uint32(12))
+
+	broadcaster := new(logmocks.Broadcaster)
+	broadcaster.Test(t)
+	broadcaster.On("NewListenerQueue", "EVMLog", int32(1), mock.Anything, mock.Anything).Return(
+		func(name string, jobID int32, capacity uint64, parseLog log.ParseLogFunc) *log.ListenerQueue {
+			return log.NewListenerQueue(name, jobID, *evmChainID, capacity, log.OverflowPolicyDropOldest, parseLog, nil, lggr)
+		})
+
+	chain := new(evmmocks.Chain)
+	chain.Test(t)
+	chain.On("Config").Return(cfg)
+	chain.On("LogBroadcaster").Return(broadcaster)
+
+	// The event filter is registered with the log poller
+	logPoller := new(logpollermocks.LogPoller)
+	logPoller.Test(t)
+	logPoller.On("MergeFilter", []common.Hash{transferTopic}, []common.Address{common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C")}).Once()
+	chain.On("LogPoller").Return(logPoller)
+
+	chainSet := new(evmmocks.ChainSet)
+	chainSet.Test(t)
+	chainSet.On("Get", evmChainID).Return(chain, nil)
+
+	runner := new(pipelinemocks.Runner)
+	runner.Test(t)
+
+	jb := job.Job{
+		ID:            1,
+		Type:          job.EVMLog,
+		ExternalJobID: uuid.NewV4(),
+		EVMLogSpec: &job.EVMLogSpec{
+			ContractAddress: "0x613a38AC1659769640aaE063C651F48E0250454C",
+			EventABI:        transferABI,
+			TopicFilters:    job.TopicFilters{"to": {toAddress.Hex()}},
+			EVMChainID:      utils.NewBig(evmChainID),
+		},
+		PipelineSpec: &pipeline.Spec{ID: 1},
+	}
+
+	delegate := evmlog.NewDelegate(lggr, runner, chainSet)
+	services, err := delegate.ServicesForSpec(jb)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	logPoller.AssertExpectations(t)
+	listener := services[0].(log.Listener)
+
+	var opts log.ListenerOpts
+	broadcaster.On("Register", listener, mock.Anything).Return(func() {}).Run(func(args mock.Arguments) {
+		opts = args.Get(1).(log.ListenerOpts)
+	}).Once()
+	require.NoError(t, services[0].Start())
+	defer services[0].Close()
+
+	assert.Equal(t, common.HexToAddress("0x613a38AC1659769640aaE063C651F48E0250454C"), opts.Contract)
+	assert.Equal(t, uint32(12), opts.MinIncomingConfirmations)
+	assert.Equal(t, map[common.Hash][][]log.Topic{
+		transferTopic: {nil, {log.Topic(toAddress.Hash())}},
+	}, opts.LogsWithTopics)
+
+	rawLog := types.Log{
+		Address:     opts.Contract,
+		Topics:      []common.Hash{transferTopic, fromAddress.Hash(), toAddress.Hash()},
+		Data:        common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+		BlockNumber: 10,
+		BlockHash:   common.HexToHash("0x10"),
+		TxHash:      common.HexToHash("0x20"),
+	}
+
+	t.Run("rejects logs of an event with other indexed arguments", func(t *testing.T) {
+		otherLog := rawLog
+		otherLog.Topics = otherLog.Topics[:2]
+		otherLog.Data = append(common.LeftPadBytes(toAddress.Bytes(), 32), otherLog.Data...)
+		_, err := opts.ParseLog(otherLog)
+		require.Error(t, err)
+	})
+
+	decodedLog, err := opts.ParseLog(rawLog)
+	require.NoError(t, err)
+	assert.Equal(t, transferTopic, decodedLog.Topic())
+	lb := log.NewLogBroadcast(rawLog, *evmChainID, decodedLog)
+
+	broadcaster.On("WasAlreadyConsumed", lb).Return(false, nil)
+	broadcaster.On("MarkConsumed", lb, mock.Anything).Return(nil)
+
+	runs := make(chan pipeline.Run, 1)
+	runner.On("Run", mock.Anything, mock.Anything, mock.Anything, true, mock.Anything).Return(false, nil).Run(func(args mock.Arguments) {
+		fn := args.Get(4).(func(pg.Queryer) error)
+		assert.NoError(t, fn(nil))
+		runs <- *args.Get(1).(*pipeline.Run)
+	}).Once()
+
+	listener.HandleLog(lb)
+
+	select {
+	case run := <-runs:
+		vars := run.Inputs.Val.(map[string]interface{})
+		jobRun := vars["jobRun"].(map[string]interface{})
+		assert.Equal(t, rawLog.BlockHash, jobRun["logBlockHash"])
+		assert.Equal(t, rawLog.BlockNumber, jobRun["logBlockNumber"])
+		assert.Equal(t, rawLog.TxHash, jobRun["logTxHash"])
+		assert.Equal(t, map[string]interface{}{
+			"from":  fromAddress,
+			"to":    toAddress,
+			"value": big.NewInt(100),
+		}, jobRun["logData"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a pipeline run")
+	}
+
+	runner.AssertExpectations(t)
+	broadcaster.AssertExpectations(t)
+}
@@ -0,0 +1,96 @@
+package evmlog
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+type EVMLogToml struct {
+	ContractAddress          ethkey.EIP55Address `toml:"contractAddress"`
+	EventABI                 string              `toml:"eventABI"`
+	TopicFilters             job.TopicFilters    `toml:"topicFilters"`
+	MinIncomingConfirmations clnull.Uint32       `toml:"minIncomingConfirmations"`
+	EVMChainID               *utils.Big          `toml:"evmChainID"`
+}
+
+// ValidatedEVMLogSpec parses and validates an evmlog job spec.
+func ValidatedEVMLogSpec(tomlString string) (job.Job, error) {
+	var jb = job.Job{}
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, err
+	}
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, err
+	}
+	var spec EVMLogToml
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, err
+	}
+	jb.EVMLogSpec = &job.EVMLogSpec{
+		ContractAddress:          spec.ContractAddress,
+		EventABI:                 spec.EventABI,
+		TopicFilters:             spec.TopicFilters,
+		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		EVMChainID:               spec.EVMChainID,
+	}
+
+	if jb.Type != job.EVMLog {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+	if spec.ContractAddress == "" {
+		return jb, errors.New("contractAddress is required")
+	}
+	event, err := pipeline.ParseETHABIEvent(spec.EventABI)
+	if err != nil {
+		return jb, errors.Wrap(err, "invalid eventABI")
+	}
+	if _, err = topicValueFilters(event, spec.TopicFilters); err != nil {
+		return jb, err
+	}
+	return jb, nil
+}
+
+// topicValueFilters converts topic filters keyed by argument name into the
+// filters of log.ListenerOpts, which are ordered like the indexed arguments of
+// the event.
+func topicValueFilters(event abi.Event, filters job.TopicFilters) ([][]log.Topic, error) {
+	var indexedArgs abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexedArgs = append(indexedArgs, arg)
+		}
+	}
+
+	valueFilters := make([][]log.Topic, len(indexedArgs))
+	for name, values := range filters {
+		pos := -1
+		for i, arg := range indexedArgs {
+			if arg.Name == name {
+				pos = i
+				break
+			}
+		}
+		if pos < 0 {
+			return nil, errors.Errorf("topicFilters: %s is not an indexed argument of %s", name, event.Name)
+		}
+		for _, value := range values {
+			topic, err := pipeline.ETHABIEventTopic(indexedArgs[pos], value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "topicFilters: invalid value %q for %s", value, name)
+			}
+			valueFilters[pos] = append(valueFilters[pos], log.Topic(topic))
+		}
+	}
+	return valueFilters, nil
+}
@@ -0,0 +1,138 @@
+package evmlog_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/evmlog"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestValidatedEVMLogSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, jb job.Job, err error)
+	}{
+		{
+			name: "valid spec",
+			toml: `
+type                     = "evmlog"
+schemaVersion            = 1
+contractAddress          = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI                 = "Transfer(address indexed from, address indexed to, uint256 value)"
+minIncomingConfirmations = 3
+evmChainID               = 42
+observationSource        = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+
+[topicFilters]
+to = ["0xaaaa1F8ee20f5565510B84f9353F1E333E753B7a", "0xbbbb70F0e81C6F3430dfdC9fa02fB22BdD818C4e"]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, jb.EVMLogSpec)
+				assert.Equal(t, job.EVMLog, jb.Type)
+				assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", jb.EVMLogSpec.ContractAddress.String())
+				assert.Equal(t, "Transfer(address indexed from, address indexed to, uint256 value)", jb.EVMLogSpec.EventABI)
+				assert.Equal(t, job.TopicFilters{"to": {"0xaaaa1F8ee20f5565510B84f9353F1E333E753B7a", "0xbbbb70F0e81C6F3430dfdC9fa02fB22BdD818C4e"}}, jb.EVMLogSpec.TopicFilters)
+				assert.Equal(t, uint32(3), jb.EVMLogSpec.MinIncomingConfirmations.Uint32)
+				assert.Equal(t, "42", jb.EVMLogSpec.EVMChainID.String())
+			},
+		},
+		{
+			name: "no topic filters or confirmations",
+			toml: `
+type              = "evmlog"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				assert.Empty(t, jb.EVMLogSpec.TopicFilters)
+				assert.False(t, jb.EVMLogSpec.MinIncomingConfirmations.Valid)
+			},
+		},
+		{
+			name: "missing contract address",
+			toml: `
+type              = "evmlog"
+schemaVersion     = 1
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "contractAddress is required")
+			},
+		},
+		{
+			name: "invalid event ABI",
+			toml: `
+type              = "evmlog"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from,"
+observationSource = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid eventABI")
+			},
+		},
+		{
+			name: "topic filter on a non-indexed argument",
+			toml: `
+type              = "evmlog"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+
+[topicFilters]
+value = ["1"]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "topicFilters: value is not an indexed argument of Transfer")
+			},
+		},
+		{
+			name: "invalid topic filter value",
+			toml: `
+type              = "evmlog"
+schemaVersion     = 1
+contractAddress   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI          = "Transfer(address indexed from, address indexed to, uint256 value)"
+observationSource = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+
+[topicFilters]
+to = ["not an address"]
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `topicFilters: invalid value "not an address" for to`)
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := evmlog.ValidatedEVMLogSpec(tc.toml)
+			tc.assertion(t, s, err)
+		})
+	}
+}
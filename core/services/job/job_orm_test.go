@@ -624,6 +624,122 @@ func Test_FindPipelineRuns(t *testing.T) {
 	})
 }
 
+func Test_SearchPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	require.NoError(t, keyStore.OCR().Add(cltest.DefaultOCRKey))
+	require.NoError(t, keyStore.P2P().Add(cltest.DefaultP2PKey))
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+	_, bridge2 := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{}, config)
+
+	_, address := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	jb, err := offchainreporting.ValidatedOracleSpecToml(cc,
+		testspecs.GenerateOCRSpec(testspecs.OCRSpecParams{
+			JobID:              uuid.NewV4().String(),
+			TransmitterAddress: address.Hex(),
+			DS1BridgeName:      bridge.Name.String(),
+			DS2BridgeName:      bridge2.Name.String(),
+		}).Toml(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	now := time.Now()
+	insertRun := func(state pipeline.RunStatus, createdAt time.Time, taskType pipeline.TaskType, output interface{}, taskErr string) pipeline.Run {
+		run := pipeline.Run{
+			PipelineSpecID: jb.PipelineSpecID,
+			State:          state,
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{output}, Valid: true},
+			AllErrors:      pipeline.RunErrors{null.NewString(taskErr, taskErr != "")},
+			FatalErrors:    pipeline.RunErrors{null.NewString(taskErr, taskErr != "")},
+			CreatedAt:      createdAt,
+			FinishedAt:     null.TimeFrom(createdAt.Add(time.Second)),
+			PipelineTaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.NewV4(),
+				Type:       taskType,
+				DotID:      "ds1",
+				Output:     pipeline.JSONSerializable{Val: output, Valid: output != nil},
+				Error:      null.NewString(taskErr, taskErr != ""),
+				CreatedAt:  createdAt,
+				FinishedAt: null.TimeFrom(createdAt.Add(time.Second)),
+			}},
+		}
+		require.NoError(t, pipelineORM.InsertFinishedRun(&run, true))
+		return run
+	}
+	completed := insertRun(pipeline.RunStatusCompleted, now.Add(-3*time.Hour), pipeline.TaskTypeHTTP, "42", "")
+	httpErrored := insertRun(pipeline.RunStatusErrored, now.Add(-2*time.Hour), pipeline.TaskTypeHTTP, nil, "connection TIMEOUT")
+	bridgeErrored := insertRun(pipeline.RunStatusErrored, now.Add(-30*time.Minute), pipeline.TaskTypeBridge, nil, "bridge timeout")
+	running := mustInsertPipelineRun(t, pipelineORM, jb)
+
+	oneHourAgo := now.Add(-time.Hour)
+	tests := []struct {
+		name     string
+		filter   job.PipelineRunsFilter
+		expected []pipeline.Run
+	}{
+		{"no filter", job.PipelineRunsFilter{}, []pipeline.Run{running, bridgeErrored, httpErrored, completed}},
+		{"job", job.PipelineRunsFilter{JobIDs: []int32{jb.ID}}, []pipeline.Run{running, bridgeErrored, httpErrored, completed}},
+		{"other job", job.PipelineRunsFilter{JobIDs: []int32{jb.ID + 1}}, nil},
+		{"status", job.PipelineRunsFilter{States: []pipeline.RunStatus{pipeline.RunStatusErrored, pipeline.RunStatusRunning}}, []pipeline.Run{running, bridgeErrored, httpErrored}},
+		{"created after", job.PipelineRunsFilter{CreatedAfter: &oneHourAgo}, []pipeline.Run{running, bridgeErrored}},
+		{"created before", job.PipelineRunsFilter{CreatedBefore: &oneHourAgo}, []pipeline.Run{httpErrored, completed}},
+		{"failed task type", job.PipelineRunsFilter{FailedTaskType: pipeline.TaskTypeHTTP}, []pipeline.Run{httpErrored}},
+		{"error contains", job.PipelineRunsFilter{ErrorContains: "timeout"}, []pipeline.Run{bridgeErrored, httpErrored}},
+		{"error contains on failed task type", job.PipelineRunsFilter{FailedTaskType: pipeline.TaskTypeBridge, ErrorContains: "Timeout"}, []pipeline.Run{bridgeErrored}},
+		{"error contains wildcard", job.PipelineRunsFilter{ErrorContains: "%"}, nil},
+		{"output contains", job.PipelineRunsFilter{OutputContains: "42"}, []pipeline.Run{completed}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, next, err := orm.SearchPipelineRuns(tt.filter, nil, 10)
+			require.NoError(t, err)
+			assert.Nil(t, next)
+			require.Len(t, runs, len(tt.expected))
+			for i := range runs {
+				assert.Equal(t, tt.expected[i].ID, runs[i].ID)
+				assert.Equal(t, jb.ID, runs[i].PipelineSpec.JobID)
+			}
+
+			count, err := orm.CountPipelineRuns(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.expected), count)
+		})
+	}
+
+	t.Run("paginates with a cursor", func(t *testing.T) {
+		filter := job.PipelineRunsFilter{States: []pipeline.RunStatus{pipeline.RunStatusErrored, pipeline.RunStatusCompleted}}
+
+		runs, next, err := orm.SearchPipelineRuns(filter, nil, 2)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, bridgeErrored.ID, runs[0].ID)
+		assert.Equal(t, httpErrored.ID, runs[1].ID)
+		require.NotNil(t, next)
+
+		cursor, err := job.ParsePipelineRunsCursor(next.String())
+		require.NoError(t, err)
+		runs, next, err = orm.SearchPipelineRuns(filter, &cursor, 2)
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, completed.ID, runs[0].ID)
+		assert.Nil(t, next)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, _, err := orm.SearchPipelineRuns(job.PipelineRunsFilter{States: []pipeline.RunStatus{"bogus"}}, nil, 10)
+		require.EqualError(t, err, `invalid run status "bogus", must be one of running, suspended, errored or completed`)
+	})
+}
+
 func Test_PipelineRunsByJobID(t *testing.T) {
 	t.Parallel()
 
@@ -36,6 +36,27 @@ func (_m *ORM) Close() error {
 	return r0
 }
 
+// CountPipelineRuns provides a mock function with given fields: filter
+func (_m *ORM) CountPipelineRuns(filter job.PipelineRunsFilter) (int, error) {
+	ret := _m.Called(filter)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter) int); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountPipelineRunsByJobID provides a mock function with given fields: jobID
 func (_m *ORM) CountPipelineRunsByJobID(jobID int32) (int32, error) {
 	ret := _m.Called(jobID)
@@ -475,6 +496,38 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// SearchPipelineRuns provides a mock function with given fields: filter, after, limit
+func (_m *ORM) SearchPipelineRuns(filter job.PipelineRunsFilter, after *job.PipelineRunsCursor, limit int) ([]pipeline.Run, *job.PipelineRunsCursor, error) {
+	ret := _m.Called(filter, after, limit)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(job.PipelineRunsFilter, *job.PipelineRunsCursor, int) []pipeline.Run); ok {
+		r0 = rf(filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 *job.PipelineRunsCursor
+	if rf, ok := ret.Get(1).(func(job.PipelineRunsFilter, *job.PipelineRunsCursor, int) *job.PipelineRunsCursor); ok {
+		r1 = rf(filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*job.PipelineRunsCursor)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(job.PipelineRunsFilter, *job.PipelineRunsCursor, int) error); ok {
+		r2 = rf(filter, after, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
@@ -39,6 +39,8 @@ const (
 	BlockhashStore     Type = "blockhashstore"
 	Webhook            Type = "webhook"
 	Bootstrap          Type = "bootstrap"
+	EVMLog             Type = "evmlog"
+	BlockTrigger       Type = "blocktrigger"
 )
 
 //revive:disable:redefines-builtin-id
@@ -72,6 +74,8 @@ var (
 		Webhook:            true,
 		BlockhashStore:     false,
 		Bootstrap:          false,
+		EVMLog:             true,
+		BlockTrigger:       true,
 	}
 	supportsAsync = map[Type]bool{
 		Cron:               true,
@@ -84,6 +88,8 @@ var (
 		Webhook:            true,
 		BlockhashStore:     false,
 		Bootstrap:          false,
+		EVMLog:             true,
+		BlockTrigger:       true,
 	}
 	schemaVersions = map[Type]uint32{
 		Cron:               1,
@@ -96,6 +102,8 @@ var (
 		Webhook:            1,
 		BlockhashStore:     1,
 		Bootstrap:          1,
+		EVMLog:             1,
+		BlockTrigger:       1,
 	}
 )
 
@@ -122,6 +130,10 @@ type Job struct {
 	BlockhashStoreSpec             *BlockhashStoreSpec
 	BootstrapSpec                  *BootstrapSpec
 	BootstrapSpecID                *int32
+	EVMLogSpecID                   *int32
+	EVMLogSpec                     *EVMLogSpec
+	BlockTriggerSpecID             *int32
+	BlockTriggerSpec               *BlockTriggerSpec
 	PipelineSpecID                 int32
 	PipelineSpec                   *pipeline.Spec
 	JobSpecErrors                  []SpecError
@@ -129,6 +141,9 @@ type Job struct {
 	SchemaVersion                  uint32
 	Name                           null.String
 	MaxTaskDuration                models.Interval
+	RunRetentionMaxAge             models.Interval
+	RunRetentionMaxFailedAge       models.Interval
+	RunRetentionMaxRuns            uint32
 	Pipeline                       pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt                      time.Time
 }
@@ -307,8 +322,15 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// InputSchema is an optional JSON Schema the request body of a run must
+	// be valid against before the run is created.
+	InputSchema null.String `json:"inputSchema" toml:"inputSchema"`
+	// Sync makes a run request wait up to SyncTimeout for the run to finish,
+	// so that the response has the outputs of the run.
+	Sync        bool          `json:"sync" toml:"sync"`
+	SyncTimeout time.Duration `json:"syncTimeout" toml:"syncTimeout"`
+	CreatedAt   time.Time     `json:"createdAt" toml:"-"`
+	UpdatedAt   time.Time     `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -337,12 +359,34 @@ type DirectRequestSpec struct {
 }
 
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32  `toml:"-"`
+	CronSchedule string `toml:"schedule"`
+	// Jitter is the maximum random delay of a run after its scheduled time.
+	Jitter time.Duration `toml:"jitter"`
+	// MaxConcurrentRuns is the maximum number of runs in progress at once,
+	// or 0 for no limit.
+	MaxConcurrentRuns uint32 `toml:"maxConcurrentRuns"`
+	// ConcurrencyPolicy is what happens to a scheduled run while
+	// MaxConcurrentRuns runs are in progress.
+	ConcurrencyPolicy CronConcurrencyPolicy `toml:"concurrencyPolicy"`
+	// CatchUp runs the schedules missed since the last successful run when
+	// the job starts, e.g. after the node was down.
+	CatchUp   bool      `toml:"catchUp"`
+	CreatedAt time.Time `toml:"-"`
+	UpdatedAt time.Time `toml:"-"`
 }
 
+// CronConcurrencyPolicy is what a cron job does with a scheduled run while
+// it has MaxConcurrentRuns runs in progress.
+type CronConcurrencyPolicy string
+
+const (
+	// CronConcurrencySkip skips the scheduled run.
+	CronConcurrencySkip CronConcurrencyPolicy = "skip"
+	// CronConcurrencyQueue starts the scheduled run once another run is done.
+	CronConcurrencyQueue CronConcurrencyPolicy = "queue"
+)
+
 func (s CronSpec) GetID() string {
 	return fmt.Sprintf("%v", s.ID)
 }
@@ -464,6 +508,72 @@ type BlockhashStoreSpec struct {
 	UpdatedAt time.Time `toml:"-"`
 }
 
+// EVMLogSpec defines the job spec for jobs run by the logs of a contract.
+type EVMLogSpec struct {
+	ID int32
+
+	// ContractAddress is the address of the contract emitting the logs.
+	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
+
+	// EventABI is the signature of the event, with the names of its
+	// arguments, e.g. "Transfer(address indexed from, address indexed to, uint256 value)".
+	EventABI string `toml:"eventABI"`
+
+	// TopicFilters restricts the logs to those whose indexed arguments have
+	// one of the listed values. It is keyed by argument name.
+	TopicFilters TopicFilters `toml:"topicFilters"`
+
+	// MinIncomingConfirmations is the number of confirmations a log needs
+	// before it runs the job. Defaults to MIN_INCOMING_CONFIRMATIONS.
+	MinIncomingConfirmations clnull.Uint32 `toml:"minIncomingConfirmations"`
+
+	// EVMChainID is the chain of the contract.
+	EVMChainID *utils.Big `toml:"evmChainID"`
+
+	// CreatedAt is the time this job was created.
+	CreatedAt time.Time `toml:"-"`
+
+	// UpdatedAt is the time this job was last updated.
+	UpdatedAt time.Time `toml:"-"`
+}
+
+// TopicFilters maps the names of indexed event arguments to the values they
+// are allowed to have.
+type TopicFilters map[string][]string
+
+func (f TopicFilters) Value() (driver.Value, error) {
+	if f == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(f)
+}
+
+func (f *TopicFilters) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, f)
+}
+
+// BlockTriggerSpec defines the job spec for jobs run by new heads.
+type BlockTriggerSpec struct {
+	ID int32
+
+	// BlockInterval is the number of blocks between runs. The job runs on
+	// every head whose number is a multiple of it.
+	BlockInterval uint32 `toml:"blockInterval"`
+
+	// EVMChainID is the chain whose heads run the job.
+	EVMChainID *utils.Big `toml:"evmChainID"`
+
+	// CreatedAt is the time this job was created.
+	CreatedAt time.Time `toml:"-"`
+
+	// UpdatedAt is the time this job was last updated.
+	UpdatedAt time.Time `toml:"-"`
+}
+
 // BootstrapSpec defines the spec to handles the node communication setup process.
 type BootstrapSpec struct {
 	ID                                int32              `toml:"-"`
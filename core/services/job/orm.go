@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
@@ -54,6 +55,8 @@ type ORM interface {
 	FindSpecError(id int64, qopts ...pg.QOpt) (SpecError, error)
 	Close() error
 	PipelineRuns(jobID *int32, offset, size int) ([]pipeline.Run, int, error)
+	SearchPipelineRuns(filter PipelineRunsFilter, after *PipelineRunsCursor, limit int) (runs []pipeline.Run, next *PipelineRunsCursor, err error)
+	CountPipelineRuns(filter PipelineRunsFilter) (count int, err error)
 
 	FindPipelineRunIDsByJobID(jobID int32, offset, limit int) (ids []int64, err error)
 	FindPipelineRunsByIDs(ids []int64) (runs []pipeline.Run, err error)
@@ -223,8 +226,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.KeeperSpecID = &specID
 		case Cron:
 			var specID int32
-			sql := `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+			sql := `INSERT INTO cron_specs (cron_schedule, jitter, max_concurrent_runs, concurrency_policy, catch_up, created_at, updated_at)
+			VALUES (:cron_schedule, :jitter, :max_concurrent_runs, :concurrency_policy, :catch_up, NOW(), NOW())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.CronSpec); err != nil {
 				return errors.Wrap(err, "failed to create CronSpec")
@@ -289,6 +292,24 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 				return errors.Wrap(err, "failed to create BootstrapSpec for jobSpec")
 			}
 			jb.BootstrapSpecID = &specID
+		case EVMLog:
+			var specID int32
+			sql := `INSERT INTO evm_log_specs (contract_address, event_abi, topic_filters, min_incoming_confirmations, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :event_abi, :topic_filters, :min_incoming_confirmations, :evm_chain_id, NOW(), NOW())
+			RETURNING id;`
+			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.EVMLogSpec); err != nil {
+				return errors.Wrap(err, "failed to create EVMLogSpec")
+			}
+			jb.EVMLogSpecID = &specID
+		case BlockTrigger:
+			var specID int32
+			sql := `INSERT INTO block_trigger_specs (block_interval, evm_chain_id, created_at, updated_at)
+			VALUES (:block_interval, :evm_chain_id, NOW(), NOW())
+			RETURNING id;`
+			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.BlockTriggerSpec); err != nil {
+				return errors.Wrap(err, "failed to create BlockTriggerSpec")
+			}
+			jb.BlockTriggerSpecID = &specID
 		default:
 			o.lggr.Panicf("Unsupported jb.Type: %v", jb.Type)
 		}
@@ -312,18 +333,18 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 
 func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO webhook_specs (created_at, updated_at)
-			VALUES (NOW(), NOW())
+	query := `INSERT INTO webhook_specs (input_schema, sync, sync_timeout, created_at, updated_at)
+			VALUES (:input_schema, :sync, :sync_timeout, NOW(), NOW())
 			RETURNING *;`
 	return q.GetNamed(query, webhookSpec, webhookSpec)
 }
 
 func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
-				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, external_job_id, created_at)
-		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :offchainreporting_oracle_spec_id, :offchainreporting2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
-				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :external_job_id, NOW())
+	query := `INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, run_retention_max_age, run_retention_max_failed_age, run_retention_max_runs, offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id,
+				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, bootstrap_spec_id, evm_log_spec_id, block_trigger_spec_id, external_job_id, created_at)
+		VALUES (:pipeline_spec_id, :name, :schema_version, :type, :max_task_duration, :run_retention_max_age, :run_retention_max_failed_age, :run_retention_max_runs, :offchainreporting_oracle_spec_id, :offchainreporting2_oracle_spec_id, :direct_request_spec_id, :flux_monitor_spec_id,
+				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :bootstrap_spec_id, :evm_log_spec_id, :block_trigger_spec_id, :external_job_id, NOW())
 		RETURNING *;`
 	return q.GetNamed(query, job, job)
 }
@@ -345,7 +366,9 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 				webhook_spec_id,
 				direct_request_spec_id,
 				blockhash_store_spec_id,
-				bootstrap_spec_id
+				bootstrap_spec_id,
+				evm_log_spec_id,
+				block_trigger_spec_id
 		),
 		deleted_oracle_specs AS (
 			DELETE FROM offchainreporting_oracle_specs WHERE id IN (SELECT offchainreporting_oracle_spec_id FROM deleted_jobs)
@@ -376,6 +399,12 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		),
 		deleted_bootstrap_specs AS (
 			DELETE FROM bootstrap_specs WHERE id IN (SELECT bootstrap_spec_id FROM deleted_jobs)
+		),
+		deleted_evm_log_specs AS (
+			DELETE FROM evm_log_specs WHERE id IN (SELECT evm_log_spec_id FROM deleted_jobs)
+		),
+		deleted_block_trigger_specs AS (
+			DELETE FROM block_trigger_specs WHERE id IN (SELECT block_trigger_spec_id FROM deleted_jobs)
 		)
 		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
 	res, cancel, err := q.ExecQIter(query, id)
@@ -689,7 +718,7 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 			if err = rows.Scan(&id, &source); err != nil {
 				return err
 			}
-			ids = append(jids, id)
+			ids = append(ids, id)
 			sources = append(sources, source)
 		}
 
@@ -873,6 +902,62 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 	return runs, count, errors.Wrap(err, "PipelineRuns failed")
 }
 
+// SearchPipelineRuns returns up to limit runs matching filter, from newest to
+// oldest, starting after the run at cursor after, if given. next is the
+// cursor of the last run returned if there are more runs to fetch.
+func (o *orm) SearchPipelineRuns(filter PipelineRunsFilter, after *PipelineRunsCursor, limit int) (runs []pipeline.Run, next *PipelineRunsCursor, err error) {
+	if err = filter.Validate(); err != nil {
+		return nil, nil, err
+	}
+	var args []interface{}
+	conds := filter.conditions(&args)
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conds = append(conds, fmt.Sprintf("(pipeline_runs.created_at, pipeline_runs.id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+	var where string
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		// Fetch one extra run to find out whether there is a next page
+		sql := fmt.Sprintf(`SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id%s
+		ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC
+		LIMIT $%d
+		;`, where, len(args)+1)
+		if err = tx.Select(&runs, sql, append(args, limit+1)...); err != nil {
+			return errors.Wrap(err, "error loading runs")
+		}
+		if len(runs) > limit {
+			runs = runs[:limit]
+			last := runs[len(runs)-1]
+			next = &PipelineRunsCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+		}
+
+		runs, err = o.loadPipelineRunsRelations(runs, tx)
+
+		return err
+	})
+
+	return runs, next, errors.Wrap(err, "SearchPipelineRuns failed")
+}
+
+// CountPipelineRuns returns the number of runs matching filter.
+func (o *orm) CountPipelineRuns(filter PipelineRunsFilter) (count int, err error) {
+	if err = filter.Validate(); err != nil {
+		return 0, err
+	}
+	var args []interface{}
+	var where string
+	if conds := filter.conditions(&args); len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+	sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id%s`, where)
+	err = o.q.Get(&count, sql, args...)
+	return count, errors.Wrap(err, "CountPipelineRuns failed")
+}
+
 func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]pipeline.Run, error) {
 	// Postload PipelineSpecs
 	// TODO: We should pull this out into a generic preload function once go has generics
@@ -944,6 +1029,8 @@ func LoadAllJobTypes(tx pg.Queryer, job *Job) error {
 		loadJobType(tx, job, "VRFSpec", "vrf_specs", job.VRFSpecID),
 		loadJobType(tx, job, "BlockhashStoreSpec", "blockhash_store_specs", job.BlockhashStoreSpecID),
 		loadJobType(tx, job, "BootstrapSpec", "bootstrap_specs", job.BootstrapSpecID),
+		loadJobType(tx, job, "EVMLogSpec", "evm_log_specs", job.EVMLogSpecID),
+		loadJobType(tx, job, "BlockTriggerSpec", "block_trigger_specs", job.BlockTriggerSpecID),
 	)
 }
 
@@ -0,0 +1,135 @@
+package job
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// PipelineRunsFilter selects the runs returned by SearchPipelineRuns and
+// counted by CountPipelineRuns. Empty fields match all runs.
+type PipelineRunsFilter struct {
+	JobIDs []int32
+	States []pipeline.RunStatus
+	// CreatedAfter (inclusive) and CreatedBefore (exclusive) bound the time
+	// at which runs were created.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// FailedTaskType matches runs with an errored task of this type.
+	FailedTaskType pipeline.TaskType
+	// ErrorContains matches runs with a task error containing this string,
+	// ignoring case. Combined with FailedTaskType, the error must be that of
+	// a task of that type.
+	ErrorContains string
+	// OutputContains matches runs whose JSON encoded outputs contain this
+	// string, ignoring case.
+	OutputContains string
+}
+
+// IsEmpty returns true if the filter matches all runs.
+func (f PipelineRunsFilter) IsEmpty() bool {
+	return len(f.JobIDs) == 0 && len(f.States) == 0 && f.CreatedAfter == nil && f.CreatedBefore == nil &&
+		f.FailedTaskType == "" && f.ErrorContains == "" && f.OutputContains == ""
+}
+
+// Validate checks that the filter only refers to known run states.
+func (f PipelineRunsFilter) Validate() error {
+	for _, state := range f.States {
+		switch state {
+		case pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted:
+		default:
+			return errors.Errorf("invalid run status %q, must be one of running, suspended, errored or completed", state)
+		}
+	}
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return errors.New("createdAfter must be before createdBefore")
+	}
+	return nil
+}
+
+// conditions returns the SQL conditions on pipeline_runs and jobs matching
+// the filter, appending their arguments to args.
+func (f PipelineRunsFilter) conditions(args *[]interface{}) []string {
+	arg := func(v interface{}) string {
+		*args = append(*args, v)
+		return fmt.Sprintf("$%d", len(*args))
+	}
+
+	var conds []string
+	if len(f.JobIDs) > 0 {
+		conds = append(conds, fmt.Sprintf("jobs.id = ANY(%s)", arg(f.JobIDs)))
+	}
+	if len(f.States) > 0 {
+		states := make([]string, len(f.States))
+		for i, state := range f.States {
+			states[i] = string(state)
+		}
+		conds = append(conds, fmt.Sprintf("pipeline_runs.state::text = ANY(%s)", arg(states)))
+	}
+	if f.CreatedAfter != nil {
+		conds = append(conds, fmt.Sprintf("pipeline_runs.created_at >= %s", arg(*f.CreatedAfter)))
+	}
+	if f.CreatedBefore != nil {
+		conds = append(conds, fmt.Sprintf("pipeline_runs.created_at < %s", arg(*f.CreatedBefore)))
+	}
+	if f.FailedTaskType != "" || f.ErrorContains != "" {
+		taskConds := []string{
+			"pipeline_task_runs.pipeline_run_id = pipeline_runs.id",
+			"pipeline_task_runs.error IS NOT NULL",
+		}
+		if f.FailedTaskType != "" {
+			taskConds = append(taskConds, fmt.Sprintf("pipeline_task_runs.type = %s", arg(string(f.FailedTaskType))))
+		}
+		if f.ErrorContains != "" {
+			taskConds = append(taskConds, fmt.Sprintf("pipeline_task_runs.error ILIKE '%%' || %s || '%%'", arg(escapeLikePattern(f.ErrorContains))))
+		}
+		conds = append(conds, fmt.Sprintf("EXISTS (SELECT 1 FROM pipeline_task_runs WHERE %s)", strings.Join(taskConds, " AND ")))
+	}
+	if f.OutputContains != "" {
+		conds = append(conds, fmt.Sprintf("pipeline_runs.outputs::text ILIKE '%%' || %s || '%%'", arg(escapeLikePattern(f.OutputContains))))
+	}
+	return conds
+}
+
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// PipelineRunsCursor is the position of a run in the results of
+// SearchPipelineRuns, which are ordered from newest to oldest.
+type PipelineRunsCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// String encodes the cursor as an opaque string.
+func (c PipelineRunsCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.CreatedAt.UnixNano(), c.ID)))
+}
+
+// ParsePipelineRunsCursor decodes a cursor encoded with String.
+func ParsePipelineRunsCursor(s string) (PipelineRunsCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PipelineRunsCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 2 {
+		return PipelineRunsCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return PipelineRunsCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return PipelineRunsCursor{}, errors.Errorf("invalid cursor %q", s)
+	}
+	return PipelineRunsCursor{CreatedAt: time.Unix(0, nanos), ID: id}, nil
+}
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestPipelineRunsFilter_Conditions(t *testing.T) {
+	t.Parallel()
+
+	after := time.Unix(1640000000, 0)
+	before := after.Add(time.Hour)
+	filter := PipelineRunsFilter{
+		JobIDs:         []int32{1, 2},
+		States:         []pipeline.RunStatus{pipeline.RunStatusErrored},
+		CreatedAfter:   &after,
+		CreatedBefore:  &before,
+		FailedTaskType: pipeline.TaskTypeHTTP,
+		ErrorContains:  "50%_off",
+		OutputContains: "0x01",
+	}
+	require.NoError(t, filter.Validate())
+	assert.False(t, filter.IsEmpty())
+
+	var args []interface{}
+	conds := filter.conditions(&args)
+	assert.Equal(t, []string{
+		"jobs.id = ANY($1)",
+		"pipeline_runs.state::text = ANY($2)",
+		"pipeline_runs.created_at >= $3",
+		"pipeline_runs.created_at < $4",
+		"EXISTS (SELECT 1 FROM pipeline_task_runs WHERE pipeline_task_runs.pipeline_run_id = pipeline_runs.id AND pipeline_task_runs.error IS NOT NULL AND pipeline_task_runs.type = $5 AND pipeline_task_runs.error ILIKE '%' || $6 || '%')",
+		"pipeline_runs.outputs::text ILIKE '%' || $7 || '%'",
+	}, conds)
+	assert.Equal(t, []interface{}{
+		[]int32{1, 2},
+		[]string{"errored"},
+		after,
+		before,
+		"http",
+		`50\%\_off`,
+		"0x01",
+	}, args)
+
+	var emptyArgs []interface{}
+	assert.Empty(t, PipelineRunsFilter{}.conditions(&emptyArgs))
+	assert.Empty(t, emptyArgs)
+	assert.True(t, PipelineRunsFilter{}.IsEmpty())
+}
+
+func TestPipelineRunsFilter_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	assert.NoError(t, PipelineRunsFilter{States: []pipeline.RunStatus{
+		pipeline.RunStatusRunning, pipeline.RunStatusSuspended, pipeline.RunStatusErrored, pipeline.RunStatusCompleted,
+	}}.Validate())
+	assert.EqualError(t, PipelineRunsFilter{States: []pipeline.RunStatus{"unknown"}}.Validate(),
+		`invalid run status "unknown", must be one of running, suspended, errored or completed`)
+	assert.EqualError(t, PipelineRunsFilter{CreatedAfter: &now, CreatedBefore: &now}.Validate(),
+		"createdAfter must be before createdBefore")
+}
+
+func TestPipelineRunsCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := PipelineRunsCursor{CreatedAt: time.Unix(1640000000, 123456000), ID: 42}
+	parsed, err := ParsePipelineRunsCursor(cursor.String())
+	require.NoError(t, err)
+	assert.True(t, cursor.CreatedAt.Equal(parsed.CreatedAt))
+	assert.Equal(t, cursor.ID, parsed.ID)
+
+	for _, s := range []string{"", "!!!", "MTIz", "YTpi"} {
+		_, err := ParsePipelineRunsCursor(s)
+		assert.Error(t, err, s)
+	}
+}
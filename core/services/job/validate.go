@@ -22,6 +22,8 @@ var (
 		Webhook:            {},
 		BlockhashStore:     {},
 		Bootstrap:          {},
+		EVMLog:             {},
+		BlockTrigger:       {},
 	}
 )
 
@@ -60,6 +62,10 @@ func ValidateSpec(ts string) (Type, error) {
 		return "", errors.Errorf("async=true tasks are not supported for %v", jb.Type)
 	}
 
+	if jb.RunRetentionMaxAge < 0 || jb.RunRetentionMaxFailedAge < 0 {
+		return "", errors.New("runRetentionMaxAge and runRetentionMaxFailedAge must not be negative")
+	}
+
 	if strings.Contains(ts, "<{}>") {
 		return "", errors.Errorf("'<{}>' syntax is not supported. Please use \"{}\" instead")
 	}
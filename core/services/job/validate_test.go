@@ -91,6 +91,36 @@ ds [type=bridge async=true]
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "negative run retention",
+			spec: `
+type="vrf"
+schemaVersion=1
+runRetentionMaxFailedAge="-1h"
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.EqualError(t, err, "runRetentionMaxAge and runRetentionMaxFailedAge must not be negative")
+			},
+		},
+		{
+			name: "run retention overrides",
+			spec: `
+type="vrf"
+schemaVersion=1
+runRetentionMaxAge="1h"
+runRetentionMaxFailedAge="72h"
+runRetentionMaxRuns=100
+observationSource="""
+ds [type=http]
+"""
+`,
+			assertion: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "happy path",
 			spec: `
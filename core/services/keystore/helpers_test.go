@@ -32,4 +32,5 @@ func (m *master) ResetXXXTestOnly() {
 	m.keyRing = newKeyRing()
 	m.keyStates = newKeyStates()
 	m.password = ""
+	m.secrets.resetCache()
 }
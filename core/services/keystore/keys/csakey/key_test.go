@@ -44,3 +44,14 @@ func Test_GetPrivateKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, key.privateKey, privkey)
 }
+
+func TestKeyV2_Sign(t *testing.T) {
+	key, err := NewV2()
+	require.NoError(t, err)
+
+	msg := []byte("message")
+	sig, err := key.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(key.PublicKey, msg, sig))
+	assert.False(t, ed25519.Verify(key.PublicKey, []byte("other"), sig))
+}
@@ -69,6 +69,11 @@ func (key KeyV2) Raw() Raw {
 	return Raw(*key.privateKey)
 }
 
+// Sign signs msg with the ed25519 private key.
+func (key KeyV2) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(*key.privateKey, msg), nil
+}
+
 func (key KeyV2) String() string {
 	return fmt.Sprintf("CSAKeyV2{PrivateKey: <redacted>, PublicKey: %s}", key.PublicKey)
 }
@@ -37,6 +37,7 @@ type Master interface {
 	OCR() OCR
 	OCR2() OCR2
 	P2P() P2P
+	Secrets() Secrets
 	Solana() Solana
 	Terra() Terra
 	VRF() VRF
@@ -47,14 +48,15 @@ type Master interface {
 
 type master struct {
 	*keyManager
-	csa    *csa
-	eth    *eth
-	ocr    *ocr
-	ocr2   ocr2
-	p2p    *p2p
-	solana *solana
-	terra  *terra
-	vrf    *vrf
+	csa     *csa
+	eth     *eth
+	ocr     *ocr
+	ocr2    ocr2
+	p2p     *p2p
+	secrets *secrets
+	solana  *solana
+	terra   *terra
+	vrf     *vrf
 }
 
 func New(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger, cfg pg.LogConfig) Master {
@@ -76,6 +78,7 @@ func newMaster(db *sqlx.DB, scryptParams utils.ScryptParams, lggr logger.Logger,
 		ocr:        newOCRKeyStore(km),
 		ocr2:       newOCR2KeyStore(km),
 		p2p:        newP2PKeyStore(km),
+		secrets:    newSecretsKeyStore(km),
 		solana:     newSolanaKeyStore(km),
 		terra:      newTerraKeyStore(km),
 		vrf:        newVRFKeyStore(km),
@@ -102,6 +105,10 @@ func (ks *master) P2P() P2P {
 	return ks.p2p
 }
 
+func (ks *master) Secrets() Secrets {
+	return ks.secrets
+}
+
 func (ks *master) Solana() Solana {
 	return ks.solana
 }
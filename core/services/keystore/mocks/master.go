@@ -127,6 +127,22 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// Secrets provides a mock function with given fields:
+func (_m *Master) Secrets() keystore.Secrets {
+	ret := _m.Called()
+
+	var r0 keystore.Secrets
+	if rf, ok := ret.Get(0).(func() keystore.Secrets); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keystore.Secrets)
+		}
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
@@ -0,0 +1,258 @@
+package keystore
+
+import (
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"sync"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name Secrets --output mocks/ --case=underscore
+
+var (
+	// ErrSecretExists is returned when creating a secret with a name that is already taken
+	ErrSecretExists = errors.New("secret already exists")
+	// ErrSecretNotFound is returned when the requested secret does not exist
+	ErrSecretNotFound = errors.New("secret not found")
+
+	secretNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+)
+
+// Secret describes a stored secret. The value is deliberately omitted: once
+// stored, secret values are only ever handed to the pipeline runner.
+type Secret struct {
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type encryptedSecret struct {
+	Secret
+	EncryptedValue []byte
+}
+
+// Secrets stores node-level secrets encrypted with the keystore password, so
+// that job specs can reference them as $(secrets.<name>) rather than embedding
+// API keys in plain text.
+type Secrets interface {
+	Create(name, value string) (Secret, error)
+	Update(name, value string) (Secret, error)
+	Delete(name string) (Secret, error)
+	GetAll() ([]Secret, error)
+	// GetAllValues returns the decrypted values of all secrets keyed by name
+	GetAllValues() (map[string]string, error)
+}
+
+type secrets struct {
+	*keyManager
+
+	// values caches decrypted secrets, so that the (deliberately slow) scrypt
+	// decryption only happens once per secret rather than on every run
+	valuesMu sync.Mutex
+	values   map[string]string
+}
+
+var _ Secrets = &secrets{}
+
+func newSecretsKeyStore(km *keyManager) *secrets {
+	return &secrets{
+		keyManager: km,
+	}
+}
+
+// ValidateSecretName checks that name can be used as a pipeline keypath
+func ValidateSecretName(name string) error {
+	if !secretNameRegexp.MatchString(name) {
+		return errors.Errorf("invalid secret name %q: must start with a letter, contain only letters, digits and underscores, and be at most 64 characters long", name)
+	}
+	return nil
+}
+
+func (ks *secrets) Create(name, value string) (Secret, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return Secret{}, ErrLocked
+	}
+	if err := ValidateSecretName(name); err != nil {
+		return Secret{}, err
+	}
+	encrypted, err := ks.encryptSecret(value)
+	if err != nil {
+		return Secret{}, err
+	}
+	secret, err := ks.orm.createSecret(name, encrypted)
+	if err != nil {
+		return Secret{}, err
+	}
+	ks.setCachedValue(name, &value)
+	return secret, nil
+}
+
+func (ks *secrets) Update(name, value string) (Secret, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return Secret{}, ErrLocked
+	}
+	encrypted, err := ks.encryptSecret(value)
+	if err != nil {
+		return Secret{}, err
+	}
+	secret, err := ks.orm.updateSecret(name, encrypted)
+	if err != nil {
+		return Secret{}, err
+	}
+	ks.setCachedValue(name, &value)
+	return secret, nil
+}
+
+func (ks *secrets) Delete(name string) (Secret, error) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return Secret{}, ErrLocked
+	}
+	secret, err := ks.orm.deleteSecret(name)
+	if err != nil {
+		return Secret{}, err
+	}
+	ks.setCachedValue(name, nil)
+	return secret, nil
+}
+
+func (ks *secrets) GetAll() ([]Secret, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+	return ks.orm.getSecrets()
+}
+
+func (ks *secrets) GetAllValues() (map[string]string, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+
+	ks.valuesMu.Lock()
+	defer ks.valuesMu.Unlock()
+	if ks.values == nil {
+		encryptedSecrets, err := ks.orm.getEncryptedSecrets()
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]string, len(encryptedSecrets))
+		for _, es := range encryptedSecrets {
+			value, err := ks.decryptSecret(es.EncryptedValue)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to decrypt secret %s", es.Name)
+			}
+			values[es.Name] = value
+		}
+		ks.values = values
+	}
+
+	values := make(map[string]string, len(ks.values))
+	for name, value := range ks.values {
+		values[name] = value
+	}
+	return values, nil
+}
+
+// setCachedValue updates the decrypted value cache after a write; a nil value
+// removes the entry. Nothing is cached until the first call to GetAllValues.
+func (ks *secrets) setCachedValue(name string, value *string) {
+	ks.valuesMu.Lock()
+	defer ks.valuesMu.Unlock()
+	if ks.values == nil {
+		return
+	}
+	if value == nil {
+		delete(ks.values, name)
+	} else {
+		ks.values[name] = *value
+	}
+}
+
+func (ks *secrets) resetCache() {
+	ks.valuesMu.Lock()
+	defer ks.valuesMu.Unlock()
+	ks.values = nil
+}
+
+// caller must hold lock!
+func (ks *secrets) encryptSecret(value string) ([]byte, error) {
+	cryptoJSON, err := gethkeystore.EncryptDataV3(
+		[]byte(value),
+		[]byte(adulteratedPassword(ks.password)),
+		ks.scryptParams.N,
+		ks.scryptParams.P,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt secret")
+	}
+	return json.Marshal(&cryptoJSON)
+}
+
+// caller must hold lock!
+func (ks *secrets) decryptSecret(encrypted []byte) (string, error) {
+	var cryptoJSON gethkeystore.CryptoJSON
+	if err := json.Unmarshal(encrypted, &cryptoJSON); err != nil {
+		return "", err
+	}
+	value, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(ks.password))
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func (orm ksORM) createSecret(name string, encryptedValue []byte) (secret Secret, err error) {
+	err = orm.q.Get(&secret, `
+		INSERT INTO secrets (name, encrypted_value, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (name) DO NOTHING
+		RETURNING name, created_at, updated_at
+	`, name, encryptedValue)
+	if errors.Is(err, sql.ErrNoRows) {
+		return secret, errors.Wrapf(ErrSecretExists, "secret %s", name)
+	}
+	return secret, errors.Wrap(err, "while creating secret")
+}
+
+func (orm ksORM) updateSecret(name string, encryptedValue []byte) (secret Secret, err error) {
+	err = orm.q.Get(&secret, `
+		UPDATE secrets SET encrypted_value = $2, updated_at = NOW()
+		WHERE name = $1
+		RETURNING name, created_at, updated_at
+	`, name, encryptedValue)
+	if errors.Is(err, sql.ErrNoRows) {
+		return secret, errors.Wrapf(ErrSecretNotFound, "secret %s", name)
+	}
+	return secret, errors.Wrap(err, "while updating secret")
+}
+
+func (orm ksORM) deleteSecret(name string) (secret Secret, err error) {
+	err = orm.q.Get(&secret, `DELETE FROM secrets WHERE name = $1 RETURNING name, created_at, updated_at`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return secret, errors.Wrapf(ErrSecretNotFound, "secret %s", name)
+	}
+	return secret, errors.Wrap(err, "while deleting secret")
+}
+
+func (orm ksORM) getSecrets() (secrets []Secret, err error) {
+	err = orm.q.Select(&secrets, `SELECT name, created_at, updated_at FROM secrets ORDER BY name ASC`)
+	return secrets, errors.Wrap(err, "while loading secrets")
+}
+
+func (orm ksORM) getEncryptedSecrets() (secrets []encryptedSecret, err error) {
+	err = orm.q.Select(&secrets, `SELECT * FROM secrets`)
+	return secrets, errors.Wrap(err, "while loading encrypted secrets")
+}
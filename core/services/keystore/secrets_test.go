@@ -0,0 +1,103 @@
+package keystore_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+func Test_SecretsKeyStore_E2E(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+	ks := keyStore.Secrets()
+	reset := func() {
+		_, err := db.Exec("DELETE FROM secrets")
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+	}
+
+	t.Run("initializes with an empty state", func(t *testing.T) {
+		defer reset()
+		secrets, err := ks.GetAll()
+		require.NoError(t, err)
+		require.Len(t, secrets, 0)
+		values, err := ks.GetAllValues()
+		require.NoError(t, err)
+		require.Len(t, values, 0)
+	})
+
+	t.Run("creates, updates and deletes secrets", func(t *testing.T) {
+		defer reset()
+		secret, err := ks.Create("apiKey", "s3cr3t")
+		require.NoError(t, err)
+		assert.Equal(t, "apiKey", secret.Name)
+
+		_, err = ks.Create("apiKey", "other")
+		require.ErrorIs(t, err, keystore.ErrSecretExists)
+
+		values, err := ks.GetAllValues()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"apiKey": "s3cr3t"}, values)
+
+		_, err = ks.Update("apiKey", "n3w")
+		require.NoError(t, err)
+		_, err = ks.Update("missing", "n3w")
+		require.ErrorIs(t, err, keystore.ErrSecretNotFound)
+
+		values, err = ks.GetAllValues()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"apiKey": "n3w"}, values)
+
+		_, err = ks.Delete("apiKey")
+		require.NoError(t, err)
+		_, err = ks.Delete("apiKey")
+		require.ErrorIs(t, err, keystore.ErrSecretNotFound)
+
+		values, err = ks.GetAllValues()
+		require.NoError(t, err)
+		assert.Len(t, values, 0)
+	})
+
+	t.Run("stores values encrypted with the keystore password", func(t *testing.T) {
+		defer reset()
+		_, err := ks.Create("apiKey", "s3cr3t")
+		require.NoError(t, err)
+
+		var encrypted []byte
+		require.NoError(t, db.Get(&encrypted, `SELECT encrypted_value FROM secrets WHERE name = 'apiKey'`))
+		assert.NotContains(t, string(encrypted), "s3cr3t")
+
+		// a fresh keystore has to decrypt the stored value
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(cltest.Password))
+		values, err := ks.GetAllValues()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"apiKey": "s3cr3t"}, values)
+	})
+
+	t.Run("rejects invalid names", func(t *testing.T) {
+		defer reset()
+		for _, name := range []string{"", "1abc", "api-key", "api.key"} {
+			_, err := ks.Create(name, "s3cr3t")
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("errors when locked", func(t *testing.T) {
+		defer reset()
+		keyStore.ResetXXXTestOnly()
+		_, err := ks.GetAllValues()
+		require.ErrorIs(t, err, keystore.ErrLocked)
+		_, err = ks.Create("apiKey", "s3cr3t")
+		require.ErrorIs(t, err, keystore.ErrLocked)
+	})
+}
@@ -0,0 +1,134 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// bridgeCacheMaxEntries bounds the number of responses held by the bridge response cache
+const bridgeCacheMaxEntries = 1000
+
+var (
+	promBridgeCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_cache_hits",
+		Help: "The number of bridge task responses served from the response cache",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_cache_misses",
+		Help: "The number of bridge task requests with caching enabled that were not found in the response cache",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeStaleResponses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_stale_responses",
+		Help: "The number of times a bridge task fell back to the last good response because the bridge request failed",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeStaleResponseAge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_bridge_stale_response_age_seconds",
+		Help: "The age of the last good response most recently used as a fallback for a failed bridge request",
+	},
+		[]string{"bridge_name"},
+	)
+)
+
+// bridgeResponseCache holds the last successful response for each distinct
+// bridge request, for bridges that set a cache TTL or a stale-if-error window.
+type bridgeResponseCache struct {
+	mu         sync.Mutex
+	entries    map[string]bridgeCacheEntry
+	maxEntries int
+}
+
+type bridgeCacheEntry struct {
+	body      []byte
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+var bridgeCache = newBridgeResponseCache(bridgeCacheMaxEntries)
+
+func newBridgeResponseCache(maxEntries int) *bridgeResponseCache {
+	return &bridgeResponseCache{
+		entries:    make(map[string]bridgeCacheEntry),
+		maxEntries: maxEntries,
+	}
+}
+
+// bridgeCacheKey identifies a bridge request by the bridge name and request
+// data. The run metadata is excluded since it changes on every run.
+func bridgeCacheKey(name StringParam, requestData MapParam) (string, error) {
+	data := make(map[string]interface{}, len(requestData))
+	for k, v := range requestData {
+		if k != "meta" {
+			data[k] = v
+		}
+	}
+	bs, err := json.Marshal([]interface{}{name, data})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bs)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Get returns the cached response for key if it is no older than maxAge,
+// along with its age.
+func (c *bridgeResponseCache) Get(key string, maxAge time.Duration) ([]byte, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, exists := c.entries[key]
+	if !exists {
+		return nil, 0, false
+	}
+	now := time.Now()
+	if now.After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, 0, false
+	}
+	age := now.Sub(entry.storedAt)
+	if age > maxAge {
+		return nil, 0, false
+	}
+	return entry.body, age, true
+}
+
+// Set stores a successful response, to be kept for at most retention.
+func (c *bridgeResponseCache) Set(key string, body []byte, retention time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	now := time.Now()
+	c.entries[key] = bridgeCacheEntry{body: body, storedAt: now, expiresAt: now.Add(retention)}
+}
+
+// evict drops expired entries, or the entry closest to expiry if none have
+// expired yet. Must be called with the lock held.
+func (c *bridgeResponseCache) evict() {
+	now := time.Now()
+	var oldestKey string
+	var oldest time.Time
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+			continue
+		}
+		if oldestKey == "" || entry.expiresAt.Before(oldest) {
+			oldestKey, oldest = k, entry.expiresAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,58 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeCacheKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := bridgeCacheKey("bridge", MapParam{"data": "a", "meta": map[string]interface{}{"latestAnswer": 1}})
+	require.NoError(t, err)
+	sameKey, err := bridgeCacheKey("bridge", MapParam{"data": "a", "meta": map[string]interface{}{"latestAnswer": 2}})
+	require.NoError(t, err)
+	assert.Equal(t, key, sameKey)
+
+	otherData, err := bridgeCacheKey("bridge", MapParam{"data": "b"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherData)
+	otherBridge, err := bridgeCacheKey("other", MapParam{"data": "a"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherBridge)
+}
+
+func TestBridgeResponseCache(t *testing.T) {
+	t.Parallel()
+
+	c := newBridgeResponseCache(2)
+
+	c.Set("a", []byte("1"), time.Hour)
+	body, age, exists := c.Get("a", time.Hour)
+	require.True(t, exists)
+	assert.Equal(t, []byte("1"), body)
+	assert.Less(t, age, time.Hour)
+
+	// too old for the requested max age
+	_, _, exists = c.Get("a", 0)
+	assert.False(t, exists)
+
+	// expired entries are dropped
+	c.Set("b", []byte("2"), 0)
+	time.Sleep(time.Millisecond)
+	_, _, exists = c.Get("b", time.Hour)
+	assert.False(t, exists)
+
+	// the entry closest to expiry is evicted once full
+	c.Set("b", []byte("2"), 2*time.Hour)
+	c.Set("c", []byte("3"), 3*time.Hour)
+	_, _, exists = c.Get("a", time.Hour)
+	assert.False(t, exists)
+	_, _, exists = c.Get("b", time.Hour)
+	assert.True(t, exists)
+	_, _, exists = c.Get("c", time.Hour)
+	assert.True(t, exists)
+}
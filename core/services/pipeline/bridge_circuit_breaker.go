@@ -0,0 +1,199 @@
+package pipeline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// defaultBridgeCircuitBreakerCooldown is how long an open circuit fails fast
+// before letting a probe request through, if the bridge does not set one.
+const defaultBridgeCircuitBreakerCooldown = 30 * time.Second
+
+// bridgeRetryMinBackoff and bridgeRetryMaxBackoff bound the delay between
+// retries of a failed bridge request.
+const (
+	bridgeRetryMinBackoff = 100 * time.Millisecond
+	bridgeRetryMaxBackoff = 10 * time.Second
+)
+
+var (
+	promBridgeCircuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_bridge_circuit_breaker_state",
+		Help: "The state of the bridge circuit breaker: 0 is closed, 1 is open and 2 is half-open",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeCircuitBreakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_circuit_breaker_rejections",
+		Help: "The number of bridge requests failed without calling the bridge because its circuit breaker was open",
+	},
+		[]string{"bridge_name"},
+	)
+	promBridgeRequestRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_request_retries",
+		Help: "The number of bridge requests retried because of the bridge's retry policy",
+	},
+		[]string{"bridge_name"},
+	)
+)
+
+// ErrBridgeCircuitOpen is returned by bridge tasks that are not sent because
+// too many consecutive requests to the bridge have failed.
+var ErrBridgeCircuitOpen = errors.New("bridge circuit breaker is open")
+
+// BridgeCircuitState is the state of a bridge circuit breaker.
+type BridgeCircuitState string
+
+const (
+	// BridgeCircuitClosed lets all requests through.
+	BridgeCircuitClosed BridgeCircuitState = "closed"
+	// BridgeCircuitOpen fails all requests until the cooldown has elapsed.
+	BridgeCircuitOpen BridgeCircuitState = "open"
+	// BridgeCircuitHalfOpen lets a single probe request through, and fails
+	// other requests until it completes.
+	BridgeCircuitHalfOpen BridgeCircuitState = "half-open"
+)
+
+func (s BridgeCircuitState) metricValue() float64 {
+	switch s {
+	case BridgeCircuitOpen:
+		return 1
+	case BridgeCircuitHalfOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// BridgeCircuitBreakerStatus reports the health of a bridge as seen by its
+// circuit breaker.
+type BridgeCircuitBreakerStatus struct {
+	State               BridgeCircuitState
+	ConsecutiveFailures uint32
+	// OpenedAt is when the circuit last opened, if it is not closed.
+	OpenedAt *time.Time
+}
+
+// bridgeOutcome is the result of a bridge request, as far as the circuit
+// breaker is concerned.
+type bridgeOutcome int
+
+const (
+	bridgeOutcomeSuccess bridgeOutcome = iota
+	bridgeOutcomeFailure
+	// bridgeOutcomeUnknown is for requests that were aborted by the caller,
+	// which say nothing about the health of the bridge.
+	bridgeOutcomeUnknown
+)
+
+// bridgeCircuitBreakers tracks consecutive failures per bridge, shared by
+// all the jobs using a bridge.
+type bridgeCircuitBreakers struct {
+	mu       sync.Mutex
+	breakers map[string]*bridgeCircuitBreaker
+}
+
+type bridgeCircuitBreaker struct {
+	state               BridgeCircuitState
+	consecutiveFailures uint32
+	openedAt            time.Time
+	probing             bool
+}
+
+var bridgeBreakers = newBridgeCircuitBreakers()
+
+func newBridgeCircuitBreakers() *bridgeCircuitBreakers {
+	return &bridgeCircuitBreakers{breakers: make(map[string]*bridgeCircuitBreaker)}
+}
+
+func (c *bridgeCircuitBreakers) get(name string) *bridgeCircuitBreaker {
+	b, exists := c.breakers[name]
+	if !exists {
+		b = &bridgeCircuitBreaker{state: BridgeCircuitClosed}
+		c.breakers[name] = b
+	}
+	return b
+}
+
+// Allow returns ErrBridgeCircuitOpen if a request to the bridge must fail
+// fast. Once cooldown has elapsed since the circuit opened, a single probe
+// request is allowed. Every allowed request must be followed by a call to
+// Record.
+func (c *bridgeCircuitBreakers) Allow(name string, cooldown time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b := c.get(name)
+	switch b.state {
+	case BridgeCircuitOpen:
+		if time.Since(b.openedAt) < cooldown {
+			return ErrBridgeCircuitOpen
+		}
+		c.setState(name, b, BridgeCircuitHalfOpen)
+		b.probing = true
+		return nil
+	case BridgeCircuitHalfOpen:
+		if b.probing {
+			return ErrBridgeCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record updates the circuit with the outcome of an allowed request. The
+// circuit opens after threshold consecutive failures, or as soon as a probe
+// fails, and closes when any request succeeds.
+func (c *bridgeCircuitBreakers) Record(name string, threshold uint32, outcome bridgeOutcome) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b := c.get(name)
+	wasProbe := b.probing
+	b.probing = false
+	switch outcome {
+	case bridgeOutcomeSuccess:
+		b.consecutiveFailures = 0
+		c.setState(name, b, BridgeCircuitClosed)
+	case bridgeOutcomeFailure:
+		b.consecutiveFailures++
+		if wasProbe || b.consecutiveFailures >= threshold {
+			b.openedAt = time.Now()
+			c.setState(name, b, BridgeCircuitOpen)
+		}
+	case bridgeOutcomeUnknown:
+	}
+}
+
+// Status returns the state of the circuit of a bridge.
+func (c *bridgeCircuitBreakers) Status(name string) BridgeCircuitBreakerStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, exists := c.breakers[name]
+	if !exists {
+		return BridgeCircuitBreakerStatus{State: BridgeCircuitClosed}
+	}
+	status := BridgeCircuitBreakerStatus{State: b.state, ConsecutiveFailures: b.consecutiveFailures}
+	if b.state != BridgeCircuitClosed {
+		openedAt := b.openedAt
+		status.OpenedAt = &openedAt
+	}
+	return status
+}
+
+// setState must be called with the lock held.
+func (c *bridgeCircuitBreakers) setState(name string, b *bridgeCircuitBreaker, state BridgeCircuitState) {
+	b.state = state
+	promBridgeCircuitBreakerState.WithLabelValues(name).Set(state.metricValue())
+}
+
+// GetBridgeCircuitBreakerStatus returns the state of the circuit breaker of
+// the named bridge in this node. Bridges that have not been called yet, or
+// that do not enable the circuit breaker, are always closed.
+func GetBridgeCircuitBreakerStatus(name string) BridgeCircuitBreakerStatus {
+	return bridgeBreakers.Status(name)
+}
@@ -0,0 +1,64 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridgeCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("opens after threshold consecutive failures", func(t *testing.T) {
+		c := newBridgeCircuitBreakers()
+
+		require.NoError(t, c.Allow("a", time.Hour))
+		c.Record("a", 2, bridgeOutcomeFailure)
+		require.NoError(t, c.Allow("a", time.Hour))
+		c.Record("a", 2, bridgeOutcomeSuccess)
+		require.NoError(t, c.Allow("a", time.Hour))
+		c.Record("a", 2, bridgeOutcomeFailure)
+		assert.Equal(t, BridgeCircuitClosed, c.Status("a").State)
+		assert.Equal(t, uint32(1), c.Status("a").ConsecutiveFailures)
+
+		require.NoError(t, c.Allow("a", time.Hour))
+		c.Record("a", 2, bridgeOutcomeFailure)
+		status := c.Status("a")
+		assert.Equal(t, BridgeCircuitOpen, status.State)
+		require.NotNil(t, status.OpenedAt)
+		assert.Equal(t, ErrBridgeCircuitOpen, c.Allow("a", time.Hour))
+
+		// Other bridges are unaffected
+		require.NoError(t, c.Allow("b", time.Hour))
+		assert.Equal(t, BridgeCircuitClosed, c.Status("b").State)
+	})
+
+	t.Run("lets a single probe through after the cooldown", func(t *testing.T) {
+		c := newBridgeCircuitBreakers()
+		require.NoError(t, c.Allow("a", 0))
+		c.Record("a", 1, bridgeOutcomeFailure)
+		assert.Equal(t, BridgeCircuitOpen, c.Status("a").State)
+
+		require.NoError(t, c.Allow("a", 0))
+		assert.Equal(t, BridgeCircuitHalfOpen, c.Status("a").State)
+		assert.Equal(t, ErrBridgeCircuitOpen, c.Allow("a", 0))
+
+		// A failed probe opens the circuit again
+		c.Record("a", 1, bridgeOutcomeFailure)
+		assert.Equal(t, BridgeCircuitOpen, c.Status("a").State)
+
+		// An aborted probe lets the next request probe instead
+		require.NoError(t, c.Allow("a", 0))
+		c.Record("a", 1, bridgeOutcomeUnknown)
+		assert.Equal(t, BridgeCircuitHalfOpen, c.Status("a").State)
+		require.NoError(t, c.Allow("a", 0))
+
+		c.Record("a", 1, bridgeOutcomeSuccess)
+		status := c.Status("a")
+		assert.Equal(t, BridgeCircuitClosed, status.State)
+		assert.Equal(t, uint32(0), status.ConsecutiveFailures)
+		assert.Nil(t, status.OpenedAt)
+	})
+}
@@ -0,0 +1,123 @@
+package pipeline
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+)
+
+const (
+	// BridgeTimestampHeader holds the unix time, in seconds, at which a
+	// bridge request or response was signed.
+	BridgeTimestampHeader = "X-Chainlink-Timestamp"
+	// BridgeSignatureHeader holds the hex encoded signature of a bridge
+	// request or response.
+	BridgeSignatureHeader = "X-Chainlink-Signature"
+	// BridgePublicKeyHeader holds the hex encoded CSA public key of the node
+	// that signed a bridge request.
+	BridgePublicKeyHeader = "X-Chainlink-Public-Key"
+)
+
+// bridgeSignatureMaxAge bounds how far the timestamp of a signed bridge
+// response may be from the current time, so that old responses cannot be
+// replayed.
+const bridgeSignatureMaxAge = 5 * time.Minute
+
+// ErrBridgeResponseSignature is returned by bridge tasks whose bridge sets a
+// response public key, when the response is not validly signed with it.
+var ErrBridgeResponseSignature = errors.New("invalid bridge response signature")
+
+//go:generate mockery --name CSAKeyStore --output ./mocks/ --case=underscore
+
+type CSAKeyStore interface {
+	GetAll() ([]csakey.KeyV2, error)
+}
+
+// BridgeSigningPayload returns the message that is signed for a bridge
+// request or response: the timestamp header and the body, joined by a dot.
+func BridgeSigningPayload(timestamp string, body []byte) []byte {
+	payload := make([]byte, 0, len(timestamp)+1+len(body))
+	payload = append(payload, timestamp...)
+	payload = append(payload, '.')
+	return append(payload, body...)
+}
+
+// signBridgeRequest returns the headers signing a request to the bridge with
+// the given body, according to the bridge's signing method.
+func signBridgeRequest(bridge bridges.BridgeType, csaKeyStore CSAKeyStore, body []byte, now time.Time) (map[string]string, error) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	payload := BridgeSigningPayload(timestamp, body)
+
+	switch bridge.RequestSigning {
+	case bridges.RequestSigningNone:
+		return nil, nil
+	case bridges.RequestSigningHMAC:
+		mac := hmac.New(sha256.New, []byte(bridge.OutgoingToken))
+		mac.Write(payload)
+		return map[string]string{
+			BridgeTimestampHeader: timestamp,
+			BridgeSignatureHeader: hex.EncodeToString(mac.Sum(nil)),
+		}, nil
+	case bridges.RequestSigningCSA:
+		if csaKeyStore == nil {
+			return nil, errors.New("cannot sign bridge request: CSA keystore is not available")
+		}
+		keys, err := csaKeyStore.GetAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot sign bridge request: failed to get CSA key")
+		}
+		if len(keys) == 0 {
+			return nil, errors.New("cannot sign bridge request: no CSA key found")
+		}
+		signature, err := keys[0].Sign(payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign bridge request")
+		}
+		return map[string]string{
+			BridgeTimestampHeader: timestamp,
+			BridgeSignatureHeader: hex.EncodeToString(signature),
+			BridgePublicKeyHeader: keys[0].PublicKeyString(),
+		}, nil
+	default:
+		return nil, bridge.RequestSigning.Validate()
+	}
+}
+
+// verifyBridgeResponse checks that the response body is signed with the
+// bridge's response public key, at a time close to now.
+func verifyBridgeResponse(publicKey string, headers http.Header, body []byte, now time.Time) error {
+	pk, err := bridges.ParseResponsePublicKey(publicKey)
+	if err != nil {
+		return errors.Wrap(err, "bridge has an invalid response public key")
+	}
+
+	timestamp := headers.Get(BridgeTimestampHeader)
+	signatureHex := headers.Get(BridgeSignatureHeader)
+	if timestamp == "" || signatureHex == "" {
+		return errors.Wrapf(ErrBridgeResponseSignature, "response is missing the %s or %s header", BridgeTimestampHeader, BridgeSignatureHeader)
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrBridgeResponseSignature, "malformed %s header %q", BridgeTimestampHeader, timestamp)
+	}
+	if skew := now.Sub(time.Unix(seconds, 0)); skew > bridgeSignatureMaxAge || skew < -bridgeSignatureMaxAge {
+		return errors.Wrapf(ErrBridgeResponseSignature, "response was signed at %s, more than %s from now", time.Unix(seconds, 0).UTC(), bridgeSignatureMaxAge)
+	}
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return errors.Wrapf(ErrBridgeResponseSignature, "malformed %s header", BridgeSignatureHeader)
+	}
+	if !ed25519.Verify(pk, BridgeSigningPayload(timestamp, body), signature) {
+		return errors.Wrap(ErrBridgeResponseSignature, "signature does not match the response public key")
+	}
+	return nil
+}
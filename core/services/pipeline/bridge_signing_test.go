@@ -0,0 +1,109 @@
+package pipeline
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/csakey"
+)
+
+type csaKeyStoreStub []csakey.KeyV2
+
+func (s csaKeyStoreStub) GetAll() ([]csakey.KeyV2, error) {
+	return s, nil
+}
+
+func TestSignBridgeRequest(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"data":{"from":"ETH"}}`)
+	now := time.Unix(1640995200, 0)
+
+	t.Run("unsigned", func(t *testing.T) {
+		headers, err := signBridgeRequest(bridges.BridgeType{}, nil, body, now)
+		require.NoError(t, err)
+		assert.Nil(t, headers)
+	})
+
+	t.Run("hmac", func(t *testing.T) {
+		bridge := bridges.BridgeType{RequestSigning: bridges.RequestSigningHMAC, OutgoingToken: "token"}
+		headers, err := signBridgeRequest(bridge, nil, body, now)
+		require.NoError(t, err)
+
+		mac := hmac.New(sha256.New, []byte("token"))
+		mac.Write([]byte(`1640995200.{"data":{"from":"ETH"}}`))
+		assert.Equal(t, map[string]string{
+			BridgeTimestampHeader: "1640995200",
+			BridgeSignatureHeader: hex.EncodeToString(mac.Sum(nil)),
+		}, headers)
+	})
+
+	t.Run("csa", func(t *testing.T) {
+		key, err := csakey.NewV2()
+		require.NoError(t, err)
+
+		bridge := bridges.BridgeType{RequestSigning: bridges.RequestSigningCSA}
+		headers, err := signBridgeRequest(bridge, csaKeyStoreStub{key}, body, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, "1640995200", headers[BridgeTimestampHeader])
+		assert.Equal(t, key.PublicKeyString(), headers[BridgePublicKeyHeader])
+		signature, err := hex.DecodeString(headers[BridgeSignatureHeader])
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(key.PublicKey, BridgeSigningPayload("1640995200", body), signature))
+	})
+
+	t.Run("csa without a key", func(t *testing.T) {
+		bridge := bridges.BridgeType{RequestSigning: bridges.RequestSigningCSA}
+		_, err := signBridgeRequest(bridge, csaKeyStoreStub{}, body, now)
+		assert.EqualError(t, err, "cannot sign bridge request: no CSA key found")
+	})
+}
+
+func TestVerifyBridgeResponse(t *testing.T) {
+	t.Parallel()
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	publicKey := hex.EncodeToString(pub)
+	body := []byte(`{"data":{"result":123}}`)
+	now := time.Now()
+
+	signedHeaders := func(signedAt time.Time, body []byte) http.Header {
+		timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+		headers := http.Header{}
+		headers.Set(BridgeTimestampHeader, timestamp)
+		headers.Set(BridgeSignatureHeader, hex.EncodeToString(ed25519.Sign(priv, BridgeSigningPayload(timestamp, body))))
+		return headers
+	}
+
+	require.NoError(t, verifyBridgeResponse(publicKey, signedHeaders(now, body), body, now))
+
+	tests := []struct {
+		name    string
+		headers http.Header
+	}{
+		{"unsigned", http.Header{}},
+		{"different body", signedHeaders(now, []byte(`{"data":{"result":124}}`))},
+		{"too old", signedHeaders(now.Add(-bridgeSignatureMaxAge-time.Minute), body)},
+		{"in the future", signedHeaders(now.Add(bridgeSignatureMaxAge+time.Minute), body)},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := verifyBridgeResponse(publicKey, test.headers, body, now)
+			assert.True(t, errors.Is(err, ErrBridgeResponseSignature), "unexpected error %v", err)
+		})
+	}
+}
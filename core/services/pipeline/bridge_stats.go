@@ -0,0 +1,106 @@
+package pipeline
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// bridgeStatsWindow is the number of most recent requests to each bridge
+// that its request stats are computed over.
+const bridgeStatsWindow = 1000
+
+// BridgeRequestStats summarises the most recent requests made to a bridge by
+// bridge tasks in this node.
+type BridgeRequestStats struct {
+	// Requests is the number of requests the stats are computed over.
+	Requests int
+	// SuccessRate is the fraction of requests that got a successful
+	// response, between 0 and 1.
+	SuccessRate float64
+	// LatencyP50 and LatencyP99 are percentiles of the time taken by
+	// requests, including failed ones.
+	LatencyP50 time.Duration
+	LatencyP99 time.Duration
+}
+
+type bridgeRequestSample struct {
+	latency time.Duration
+	success bool
+}
+
+// bridgeRequestStats holds a ring buffer of recent request samples per bridge.
+type bridgeRequestStats struct {
+	mu      sync.Mutex
+	samples map[string][]bridgeRequestSample
+	next    map[string]int
+	window  int
+}
+
+var bridgeStats = newBridgeRequestStats(bridgeStatsWindow)
+
+func newBridgeRequestStats(window int) *bridgeRequestStats {
+	return &bridgeRequestStats{
+		samples: make(map[string][]bridgeRequestSample),
+		next:    make(map[string]int),
+		window:  window,
+	}
+}
+
+// Record adds a request to the stats of a bridge, replacing the oldest one
+// once the window is full.
+func (s *bridgeRequestStats) Record(name string, latency time.Duration, success bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sample := bridgeRequestSample{latency: latency, success: success}
+	samples := s.samples[name]
+	if len(samples) < s.window {
+		s.samples[name] = append(samples, sample)
+		return
+	}
+	i := s.next[name]
+	samples[i] = sample
+	s.next[name] = (i + 1) % s.window
+}
+
+// Get computes the stats of a bridge.
+func (s *bridgeRequestStats) Get(name string) BridgeRequestStats {
+	s.mu.Lock()
+	samples := s.samples[name]
+	latencies := make([]time.Duration, len(samples))
+	var successes int
+	for i, sample := range samples {
+		latencies[i] = sample.latency
+		if sample.success {
+			successes++
+		}
+	}
+	s.mu.Unlock()
+
+	if len(latencies) == 0 {
+		return BridgeRequestStats{}
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	return BridgeRequestStats{
+		Requests:    len(latencies),
+		SuccessRate: float64(successes) / float64(len(latencies)),
+		LatencyP50:  percentile(latencies, 50),
+		LatencyP99:  percentile(latencies, 99),
+	}
+}
+
+// percentile returns the nearest-rank percentile p of sorted, which must not
+// be empty.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// GetBridgeRequestStats returns the stats of the most recent requests made
+// to the named bridge by bridge tasks in this node.
+func GetBridgeRequestStats(name string) BridgeRequestStats {
+	return bridgeStats.Get(name)
+}
@@ -0,0 +1,33 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBridgeRequestStats(t *testing.T) {
+	t.Parallel()
+
+	s := newBridgeRequestStats(100)
+	assert.Equal(t, BridgeRequestStats{}, s.Get("a"))
+
+	// Fill the window twice over, so that only the last 100 requests count
+	for i := 1; i <= 200; i++ {
+		s.Record("a", time.Duration(i)*time.Millisecond, i%4 != 0)
+	}
+	s.Record("b", time.Second, false)
+
+	stats := s.Get("a")
+	assert.Equal(t, 100, stats.Requests)
+	assert.Equal(t, 0.75, stats.SuccessRate)
+	assert.Equal(t, 150*time.Millisecond, stats.LatencyP50)
+	assert.Equal(t, 199*time.Millisecond, stats.LatencyP99)
+
+	stats = s.Get("b")
+	assert.Equal(t, 1, stats.Requests)
+	assert.Equal(t, float64(0), stats.SuccessRate)
+	assert.Equal(t, time.Second, stats.LatencyP50)
+	assert.Equal(t, time.Second, stats.LatencyP99)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"net/url"
 	"reflect"
@@ -54,6 +55,9 @@ type (
 		JobPipelineMaxRunDuration() time.Duration
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
+		JobPipelineReaperFailedThreshold() time.Duration
+		JobPipelineReaperMaxRuns() uint32
+		JobPipelineReaperExportDir() string
 	}
 )
 
@@ -61,9 +65,23 @@ type (
 // which Indicates whether result of InputTask should be propagated to its dependent task.
 // If the edge between these tasks was an implicit edge, then results are not propagated. This is because
 // some tasks cannot handle an input from an edge which wasn't specified in the spec.
+// Condition is only set on edges out of a conditional task, and holds the
+// result value ("true" or "false") for which the edge is taken.
 type TaskDependency struct {
 	PropagateResult bool
 	InputTask       Task
+	Condition       string
+}
+
+// IsTaken returns true if the dependent task should run given the result of
+// the input task.
+func (d TaskDependency) IsTaken(result TaskRunResult) bool {
+	if result.Skipped {
+		return false
+	} else if d.Condition == "" {
+		return true
+	}
+	return result.Result.Error == nil && fmt.Sprintf("%v", result.Result.Value) == d.Condition
 }
 
 var (
@@ -75,16 +93,23 @@ var (
 	ErrTimeout               = errors.New("timeout")
 	ErrTaskRunFailed         = errors.New("task run failed")
 	ErrCancelled             = errors.New("task run cancelled (fail early)")
+	ErrAsyncTaskTimeout      = errors.New("timed out waiting for async task to be resumed")
 )
 
 const (
 	InputTaskKey = "input"
+	// SecretsVarsKey is the vars key under which node secrets are exposed to
+	// tasks, e.g. $(secrets.apiKey)
+	SecretsVarsKey = "secrets"
 )
 
 // RunInfo contains additional information about the finished TaskRun
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// PendingTimeout is how long a pending task waits to be resumed before
+	// it fails; zero waits forever
+	PendingTimeout time.Duration
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -113,6 +138,10 @@ func isRetryableHTTPError(statusCode int, err error) bool {
 type Result struct {
 	Value interface{}
 	Error error
+	// Details optionally describes how the value was computed, e.g. which
+	// inputs were dropped as outliers. It is persisted with the task run but
+	// not passed on to dependent tasks.
+	Details map[string]interface{}
 }
 
 // OutputDB dumps a single result output for a pipeline_run or pipeline_task_run
@@ -120,6 +149,11 @@ func (result Result) OutputDB() JSONSerializable {
 	return JSONSerializable{Val: result.Value, Valid: !(result.Value == nil || (reflect.ValueOf(result.Value).Kind() == reflect.Ptr && reflect.ValueOf(result.Value).IsNil()))}
 }
 
+// DetailsDB dumps a single result's details for a pipeline_task_run
+func (result Result) DetailsDB() JSONSerializable {
+	return JSONSerializable{Val: result.Details, Valid: result.Details != nil}
+}
+
 // ErrorDB dumps a single result error for a pipeline_task_run
 func (result Result) ErrorDB() null.String {
 	var errString null.String
@@ -176,12 +210,15 @@ type TaskRunResult struct {
 	Attempts   uint
 	CreatedAt  time.Time
 	FinishedAt null.Time
+	// Skipped is true if the task was not run because a conditional branch
+	// leading to it was not taken
+	Skipped bool
 	// runInfo is never persisted
 	runInfo RunInfo
 }
 
 func (result *TaskRunResult) IsPending() bool {
-	return !result.FinishedAt.Valid && result.Result == Result{}
+	return !result.FinishedAt.Valid && result.Result.Value == nil && result.Result.Error == nil
 }
 
 func (result *TaskRunResult) IsTerminal() bool {
@@ -306,6 +343,11 @@ const (
 	TaskTypeVRFV2            TaskType = "vrfv2"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
 	TaskTypeETHCall          TaskType = "ethcall"
+	TaskTypeETHMultiCall     TaskType = "ethmulticall"
+	TaskTypeETHBalance       TaskType = "ethbalance"
+	TaskTypeETHBlock         TaskType = "ethblock"
+	TaskTypeETHGetLogs       TaskType = "ethgetlogs"
+	TaskTypeETHStorage       TaskType = "ethstorage"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeETHABIEncode     TaskType = "ethabiencode"
 	TaskTypeETHABIEncode2    TaskType = "ethabiencode2"
@@ -314,6 +356,8 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeLowercase        TaskType = "lowercase"
 	TaskTypeUppercase        TaskType = "uppercase"
+	TaskTypeExpr             TaskType = "expr"
+	TaskTypeConditional      TaskType = "conditional"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -374,6 +418,16 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &EstimateGasLimitTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHCall:
 		task = &ETHCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHMultiCall:
+		task = &ETHMultiCallTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHBalance:
+		task = &ETHBalanceTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHBlock:
+		task = &ETHBlockTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHGetLogs:
+		task = &ETHGetLogsTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeETHStorage:
+		task = &ETHStorageTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHTx:
 		task = &ETHTxTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeETHABIEncode:
@@ -394,6 +448,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeUppercase:
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpr:
+		task = &ExprTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeConditional:
+		task = &ConditionalTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -103,6 +103,65 @@ func parseETHABIString(theABI []byte, isLog bool) (name string, args abi.Argumen
 	return name, args, indexedArgs, err
 }
 
+// ParseETHABIEvent parses an event signature such as
+// "Transfer(address indexed from, address indexed to, uint256 value)".
+func ParseETHABIEvent(theABI string) (abi.Event, error) {
+	name, args, _, err := parseETHABIString([]byte(theABI), true)
+	if err != nil {
+		return abi.Event{}, err
+	} else if name == "" {
+		return abi.Event{}, errors.Errorf("bad ABI specification, missing event name: %s", theABI)
+	}
+	return abi.NewEvent(name, name, false, args), nil
+}
+
+// ETHABIEventTopic returns the topic that a value of an indexed event argument
+// is logged as.
+func ETHABIEventTopic(arg abi.Argument, val interface{}) (common.Hash, error) {
+	converted, err := convertToETHABIType(val, arg.Type)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	topics, err := abi.MakeTopics([]interface{}{converted})
+	if err != nil {
+		return common.Hash{}, errors.Wrap(ErrBadInput, err.Error())
+	}
+	return topics[0][0], nil
+}
+
+// DecodeETHABIEventLog decodes the data and indexed topics of a log of the
+// event into a map of argument names to values.
+func DecodeETHABIEventLog(event abi.Event, data []byte, topics []common.Hash) (map[string]interface{}, error) {
+	var indexedArgs abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexedArgs = append(indexedArgs, arg)
+		}
+	}
+	return decodeETHABILog(event.Inputs, indexedArgs, data, topics)
+}
+
+// decodeETHABILog decodes the data and indexed topics of a log into a map of
+// argument names to values.
+func decodeETHABILog(args, indexedArgs abi.Arguments, data []byte, topics []common.Hash) (map[string]interface{}, error) {
+	out := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := args.UnpackIntoMap(out, data); err != nil {
+			return nil, errors.Wrap(ErrBadInput, err.Error())
+		}
+	}
+	if len(indexedArgs) > 0 {
+		if len(topics) != len(indexedArgs)+1 {
+			return nil, errors.Wrap(ErrBadInput, "topic/field count mismatch")
+		}
+		err := abi.ParseTopicsIntoMap(out, indexedArgs, topics[1:])
+		if err != nil {
+			return nil, errors.Wrap(ErrBadInput, err.Error())
+		}
+	}
+	return out, nil
+}
+
 func convertToETHABIType(val interface{}, abiType abi.Type) (interface{}, error) {
 	srcVal := reflect.ValueOf(val)
 
@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strings"
 	"testing"
@@ -146,3 +147,59 @@ func Test_convertToETHABIBytes_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestParseETHABIEvent(t *testing.T) {
+	event, err := ParseETHABIEvent("Transfer(address indexed from, address indexed to, uint256 value)")
+	require.NoError(t, err)
+	assert.Equal(t, "Transfer", event.Name)
+	assert.Equal(t, common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"), event.ID)
+	require.Len(t, event.Inputs, 3)
+	assert.True(t, event.Inputs[0].Indexed)
+	assert.False(t, event.Inputs[2].Indexed)
+
+	for _, bad := range []string{"", "(address indexed from)", "Transfer(address indexed)", "Transfer(foo bar)"} {
+		_, err := ParseETHABIEvent(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestETHABIEventTopic(t *testing.T) {
+	event, err := ParseETHABIEvent("Foo(address indexed a, uint256 indexed b, bytes32 indexed c)")
+	require.NoError(t, err)
+
+	topic, err := ETHABIEventTopic(event.Inputs[0], "0x00000000000000000000000000000000deadbeef")
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash("0xdeadbeef"), topic)
+
+	topic, err = ETHABIEventTopic(event.Inputs[1], "42")
+	require.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(42)), topic)
+
+	topic, err = ETHABIEventTopic(event.Inputs[2], "0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, common.BigToHash(big.NewInt(1)), topic)
+
+	_, err = ETHABIEventTopic(event.Inputs[0], "0x1234")
+	assert.Error(t, err)
+}
+
+func TestDecodeETHABIEventLog(t *testing.T) {
+	event, err := ParseETHABIEvent("Transfer(address indexed from, address indexed to, uint256 value)")
+	require.NoError(t, err)
+
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	data := common.BigToHash(big.NewInt(1000)).Bytes()
+	topics := []common.Hash{event.ID, from.Hash(), to.Hash()}
+
+	decoded, err := DecodeETHABIEventLog(event, data, topics)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"from":  from,
+		"to":    to,
+		"value": big.NewInt(1000),
+	}, decoded)
+
+	_, err = DecodeETHABIEventLog(event, data, topics[:2])
+	assert.Error(t, err)
+}
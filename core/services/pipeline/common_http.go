@@ -19,6 +19,7 @@ func makeHTTPRequest(
 	method StringParam,
 	url URLParam,
 	requestData MapParam,
+	requestHeaders map[string]string,
 	allowUnrestrictedNetworkAccess BoolParam,
 	httpLimit int64,
 ) ([]byte, int, http.Header, time.Duration, error) {
@@ -37,6 +38,9 @@ func makeHTTPRequest(
 		return nil, 0, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
 	request.Header.Set("Content-Type", "application/json")
+	for k, v := range requestHeaders {
+		request.Header.Set(k, v)
+	}
 
 	httpRequest := HTTPRequest{
 		Request: request,
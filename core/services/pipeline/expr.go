@@ -0,0 +1,570 @@
+package pipeline
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Expressions are parsed with the Go expression grammar but evaluated by a
+// small interpreter that only understands literals, variables, a fixed set
+// of operators and the builtin functions below. There are no loops,
+// assignments or user-defined functions, so evaluation always terminates and
+// cannot reach anything outside of the values it is given.
+//
+// Values are one of decimal.Decimal, bool or string.
+
+const (
+	// exprMaxLength is the maximum length (in bytes) of an expression
+	exprMaxLength = 4096
+	// exprMaxNodes is the maximum number of AST nodes in an expression
+	exprMaxNodes = 512
+	// exprMaxExponent and exprMaxDigits bound the numbers an expression
+	// works with, as decimal arithmetic on huge exponents (e.g. 1e999999999 + 1)
+	// would otherwise use unbounded time and memory
+	exprMaxExponent = 1000
+	exprMaxDigits   = 1000
+
+	exprInputIdent = "input"
+	exprVarPrefix  = "__chainlink_expr_var_"
+)
+
+var ErrExprInvalid = errors.New("invalid expression")
+
+type exprFunc func(args []interface{}) (interface{}, error)
+
+type exprEnv map[string]interface{}
+
+// compileExpr replaces every $(keypath) in the expression with a placeholder
+// identifier bound to the value of that keypath, then parses the result.
+func compileExpr(s string, vars Vars, inputs []Result) (ast.Expr, exprEnv, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil, errors.Wrap(ErrParameterEmpty, "expr")
+	} else if len(s) > exprMaxLength {
+		return nil, nil, errors.Wrapf(ErrExprInvalid, "expression is longer than %v bytes", exprMaxLength)
+	}
+
+	env := make(exprEnv)
+	var varErr error
+	var n int
+	replaced := variableRegexp.ReplaceAllStringFunc(s, func(expr string) string {
+		keypath := strings.TrimSpace(expr[2 : len(expr)-1])
+		val, err := vars.Get(keypath)
+		if err != nil {
+			varErr = err
+		} else if asErr, is := val.(error); is {
+			varErr = errors.Wrapf(ErrTooManyErrors, "expr: %v", asErr)
+		}
+		ident := fmt.Sprintf("%s%d", exprVarPrefix, n)
+		n++
+		env[ident] = val
+		return ident
+	})
+	if varErr != nil {
+		return nil, nil, varErr
+	}
+	if len(inputs) > 0 {
+		env[exprInputIdent] = inputs[0].Value
+	}
+
+	node, err := parser.ParseExpr(replaced)
+	if err != nil {
+		return nil, nil, errors.Wrapf(ErrExprInvalid, "%v", err)
+	}
+
+	var nodes int
+	ast.Inspect(node, func(ast.Node) bool {
+		nodes++
+		return nodes <= exprMaxNodes
+	})
+	if nodes > exprMaxNodes {
+		return nil, nil, errors.Wrapf(ErrExprInvalid, "expression has more than %v nodes", exprMaxNodes)
+	}
+	return node, env, nil
+}
+
+// EvalExpr evaluates the expression s with the given vars and inputs.
+func EvalExpr(s string, vars Vars, inputs []Result) (interface{}, error) {
+	node, env, err := compileExpr(s, vars, inputs)
+	if err != nil {
+		return nil, err
+	}
+	return env.eval(node)
+}
+
+func (env exprEnv) eval(node ast.Expr) (interface{}, error) {
+	switch n := node.(type) {
+	case *ast.ParenExpr:
+		return env.eval(n.X)
+
+	case *ast.BasicLit:
+		return evalExprLiteral(n)
+
+	case *ast.Ident:
+		switch n.Name {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		val, exists := env[n.Name]
+		if !exists {
+			if n.Name == exprInputIdent {
+				return nil, errors.Wrap(ErrWrongInputCardinality, "expression refers to input, but the task has no inputs")
+			}
+			return nil, errors.Wrapf(ErrExprInvalid, "unknown identifier %q", n.Name)
+		}
+		return normalizeExprValue(val)
+
+	case *ast.UnaryExpr:
+		x, err := env.eval(n.X)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Op {
+		case token.NOT:
+			b, err := exprBool(x)
+			if err != nil {
+				return nil, err
+			}
+			return !b, nil
+		case token.SUB:
+			d, err := exprDecimal(x)
+			if err != nil {
+				return nil, err
+			}
+			return d.Neg(), nil
+		case token.ADD:
+			return exprDecimal(x)
+		}
+		return nil, errors.Wrapf(ErrExprInvalid, "unsupported unary operator %v", n.Op)
+
+	case *ast.BinaryExpr:
+		return env.evalBinary(n)
+
+	case *ast.CallExpr:
+		return env.evalCall(n)
+	}
+	return nil, errors.Wrapf(ErrExprInvalid, "unsupported expression %T", node)
+}
+
+func (env exprEnv) evalBinary(n *ast.BinaryExpr) (interface{}, error) {
+	x, err := env.eval(n.X)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logical operators short-circuit
+	if n.Op == token.LAND || n.Op == token.LOR {
+		a, err := exprBool(x)
+		if err != nil {
+			return nil, err
+		}
+		if (n.Op == token.LAND && !a) || (n.Op == token.LOR && a) {
+			return a, nil
+		}
+		y, err := env.eval(n.Y)
+		if err != nil {
+			return nil, err
+		}
+		return exprBool(y)
+	}
+
+	y, err := env.eval(n.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case token.EQL, token.NEQ:
+		eq, err := exprEqual(x, y)
+		if err != nil {
+			return nil, err
+		}
+		return eq == (n.Op == token.EQL), nil
+
+	case token.LSS, token.GTR, token.LEQ, token.GEQ:
+		cmp, err := exprCompare(x, y)
+		if err != nil {
+			return nil, err
+		}
+		switch n.Op {
+		case token.LSS:
+			return cmp < 0, nil
+		case token.GTR:
+			return cmp > 0, nil
+		case token.LEQ:
+			return cmp <= 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+
+	case token.ADD:
+		if a, isString := x.(string); isString {
+			if b, isString := y.(string); isString {
+				return a + b, nil
+			}
+		}
+	}
+
+	a, err := exprDecimal(x)
+	if err != nil {
+		return nil, err
+	}
+	b, err := exprDecimal(y)
+	if err != nil {
+		return nil, err
+	}
+	switch n.Op {
+	case token.ADD:
+		return exprCheckDecimal(a.Add(b))
+	case token.SUB:
+		return exprCheckDecimal(a.Sub(b))
+	case token.MUL:
+		return exprCheckDecimal(a.Mul(b))
+	case token.QUO:
+		if b.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "division by zero")
+		}
+		return exprCheckDecimal(a.Div(b))
+	case token.REM:
+		if b.IsZero() {
+			return nil, errors.Wrap(ErrBadInput, "division by zero")
+		}
+		return exprCheckDecimal(a.Mod(b))
+	}
+	return nil, errors.Wrapf(ErrExprInvalid, "unsupported binary operator %v", n.Op)
+}
+
+func (env exprEnv) evalCall(n *ast.CallExpr) (interface{}, error) {
+	ident, ok := n.Fun.(*ast.Ident)
+	if !ok {
+		return nil, errors.Wrapf(ErrExprInvalid, "unsupported function call %T", n.Fun)
+	}
+
+	// ifelse only evaluates the branch that is taken, so that it can guard fail()
+	if ident.Name == "ifelse" {
+		if len(n.Args) != 3 {
+			return nil, errors.Wrapf(ErrExprInvalid, "ifelse expects 3 arguments, got %v", len(n.Args))
+		}
+		c, err := env.eval(n.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		cond, err := exprBool(c)
+		if err != nil {
+			return nil, err
+		}
+		if cond {
+			return env.eval(n.Args[1])
+		}
+		return env.eval(n.Args[2])
+	}
+
+	fn, exists := exprFuncs[ident.Name]
+	if !exists {
+		return nil, errors.Wrapf(ErrExprInvalid, "unknown function %q", ident.Name)
+	}
+	args := make([]interface{}, len(n.Args))
+	for i, arg := range n.Args {
+		val, err := env.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = val
+	}
+	val, err := fn(args)
+	return val, errors.Wrap(err, ident.Name)
+}
+
+func evalExprLiteral(lit *ast.BasicLit) (interface{}, error) {
+	switch lit.Kind {
+	case token.INT:
+		i, ok := new(big.Int).SetString(lit.Value, 0)
+		if !ok {
+			return nil, errors.Wrapf(ErrExprInvalid, "invalid integer literal %v", lit.Value)
+		}
+		return exprCheckDecimal(decimal.NewFromBigInt(i, 0))
+	case token.FLOAT:
+		d, err := decimal.NewFromString(lit.Value)
+		if err != nil {
+			return nil, errors.Wrapf(ErrExprInvalid, "invalid decimal literal %v", lit.Value)
+		}
+		return exprCheckDecimal(d)
+	case token.STRING:
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return nil, errors.Wrapf(ErrExprInvalid, "invalid string literal %v", lit.Value)
+		}
+		return s, nil
+	}
+	return nil, errors.Wrapf(ErrExprInvalid, "unsupported literal %v", lit.Value)
+}
+
+func normalizeExprValue(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case bool, string, decimal.Decimal:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case ObjectParam:
+		return normalizeObjectParam(v)
+	case *ObjectParam:
+		return normalizeObjectParam(*v)
+	}
+	var d DecimalParam
+	if err := d.UnmarshalPipelineParam(val); err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "unsupported value of type %T in expression", val)
+	}
+	return d.Decimal(), nil
+}
+
+func normalizeObjectParam(o ObjectParam) (interface{}, error) {
+	switch o.Type {
+	case BoolType:
+		return bool(o.BoolValue), nil
+	case DecimalType:
+		return o.DecimalValue.Decimal(), nil
+	case StringType:
+		return string(o.StringValue), nil
+	}
+	return nil, errors.Wrapf(ErrBadInput, "unsupported value %v in expression", o)
+}
+
+func exprDecimal(val interface{}) (decimal.Decimal, error) {
+	switch v := val.(type) {
+	case decimal.Decimal:
+		return exprCheckDecimal(v)
+	case string:
+		d, err := decimal.NewFromString(strings.TrimSpace(v))
+		if err != nil {
+			return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected number, got string %q", v)
+		}
+		return exprCheckDecimal(d)
+	}
+	return decimal.Decimal{}, errors.Wrapf(ErrBadInput, "expected number, got %T", val)
+}
+
+// exprCheckDecimal returns an error if the exponent or the number of digits
+// of d is out of the bounds of an expression.
+func exprCheckDecimal(d decimal.Decimal) (decimal.Decimal, error) {
+	if exp := d.Exponent(); exp > exprMaxExponent || exp < -exprMaxExponent {
+		return decimal.Decimal{}, errors.Wrapf(ErrExprInvalid, "number exponent %v is out of range", exp)
+	}
+	// A coefficient of up to 3000 bits has fewer than 1000 digits, so only
+	// longer ones are formatted to count their digits
+	coefficient := d.Coefficient()
+	if coefficient.BitLen() > exprMaxDigits*3 && len(coefficient.Abs(coefficient).String()) > exprMaxDigits {
+		return decimal.Decimal{}, errors.Wrapf(ErrExprInvalid, "number has more than %v digits", exprMaxDigits)
+	}
+	return d, nil
+}
+
+func exprBool(val interface{}) (bool, error) {
+	b, ok := val.(bool)
+	if !ok {
+		return false, errors.Wrapf(ErrBadInput, "expected bool, got %T", val)
+	}
+	return b, nil
+}
+
+func exprString(val interface{}) (string, error) {
+	s, ok := val.(string)
+	if !ok {
+		return "", errors.Wrapf(ErrBadInput, "expected string, got %T", val)
+	}
+	return s, nil
+}
+
+func exprEqual(x, y interface{}) (bool, error) {
+	switch a := x.(type) {
+	case bool:
+		b, err := exprBool(y)
+		return a == b, err
+	case string:
+		if b, isString := y.(string); isString {
+			return a == b, nil
+		}
+	}
+	cmp, err := exprCompare(x, y)
+	return cmp == 0, err
+}
+
+func exprCompare(x, y interface{}) (int, error) {
+	if a, isString := x.(string); isString {
+		if b, isString := y.(string); isString {
+			return strings.Compare(a, b), nil
+		}
+	}
+	a, err := exprDecimal(x)
+	if err != nil {
+		return 0, err
+	}
+	b, err := exprDecimal(y)
+	if err != nil {
+		return 0, err
+	}
+	return a.Cmp(b), nil
+}
+
+func exprDecimalArgs(args []interface{}, min, max int) ([]decimal.Decimal, error) {
+	if len(args) < min || (max >= 0 && len(args) > max) {
+		return nil, errors.Wrapf(ErrWrongInputCardinality, "got %v arguments", len(args))
+	}
+	ds := make([]decimal.Decimal, len(args))
+	for i, arg := range args {
+		d, err := exprDecimal(arg)
+		if err != nil {
+			return nil, err
+		}
+		ds[i] = d
+	}
+	return ds, nil
+}
+
+func exprStringArgs(args []interface{}, n int) ([]string, error) {
+	if len(args) != n {
+		return nil, errors.Wrapf(ErrWrongInputCardinality, "got %v arguments", len(args))
+	}
+	ss := make([]string, len(args))
+	for i, arg := range args {
+		s, err := exprString(arg)
+		if err != nil {
+			return nil, err
+		}
+		ss[i] = s
+	}
+	return ss, nil
+}
+
+func exprPlaces(d decimal.Decimal) (int32, error) {
+	if !d.Equal(d.Truncate(0)) || d.Abs().GreaterThan(decimal.NewFromInt(1000)) {
+		return 0, errors.Wrapf(ErrBadInput, "invalid number of decimal places %v", d)
+	}
+	return int32(d.IntPart()), nil
+}
+
+var exprFuncs = map[string]exprFunc{
+	"abs": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return ds[0].Abs(), nil
+	},
+	"min": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 1, -1)
+		if err != nil {
+			return nil, err
+		}
+		return decimal.Min(ds[0], ds[1:]...), nil
+	},
+	"max": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 1, -1)
+		if err != nil {
+			return nil, err
+		}
+		return decimal.Max(ds[0], ds[1:]...), nil
+	},
+	"clamp": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 3, 3)
+		if err != nil {
+			return nil, err
+		}
+		x, lo, hi := ds[0], ds[1], ds[2]
+		if lo.GreaterThan(hi) {
+			return nil, errors.Wrapf(ErrBadInput, "lower bound %v is greater than upper bound %v", lo, hi)
+		}
+		return decimal.Min(decimal.Max(x, lo), hi), nil
+	},
+	"round": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 1, 2)
+		if err != nil {
+			return nil, err
+		}
+		var places int32
+		if len(ds) == 2 {
+			if places, err = exprPlaces(ds[1]); err != nil {
+				return nil, err
+			}
+		}
+		return ds[0].Round(places), nil
+	},
+	"floor": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return ds[0].Floor(), nil
+	},
+	"ceil": func(args []interface{}) (interface{}, error) {
+		ds, err := exprDecimalArgs(args, 1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return ds[0].Ceil(), nil
+	},
+	"len": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return decimal.NewFromInt(int64(len(ss[0]))), nil
+	},
+	"lower": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToLower(ss[0]), nil
+	},
+	"upper": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return strings.ToUpper(ss[0]), nil
+	},
+	"trim": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return strings.TrimSpace(ss[0]), nil
+	},
+	"contains": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return strings.Contains(ss[0], ss[1]), nil
+	},
+	"hasPrefix": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return strings.HasPrefix(ss[0], ss[1]), nil
+	},
+	"hasSuffix": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return strings.HasSuffix(ss[0], ss[1]), nil
+	},
+	"fail": func(args []interface{}) (interface{}, error) {
+		ss, err := exprStringArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.Wrap(ErrTaskRunFailed, ss[0])
+	},
+}
@@ -95,6 +95,7 @@ type GraphEdge struct {
 
 	// Indicates that this edge was implicitly added by the pipeline parser, and not via the TOML specs.
 	isImplicit bool
+	attrs      map[string]string
 }
 
 func (e *GraphEdge) IsImplicit() bool {
@@ -105,6 +106,34 @@ func (e *GraphEdge) SetIsImplicit(isImplicit bool) {
 	e.isImplicit = isImplicit
 }
 
+// EdgeAttrWhen is the edge attribute used to make an edge out of a
+// conditional task depend on the value of its result, e.g. `cond -> submit [when=true]`
+const EdgeAttrWhen = "when"
+
+func (e *GraphEdge) SetAttribute(attr encoding.Attribute) error {
+	if e.attrs == nil {
+		e.attrs = make(map[string]string)
+	}
+	e.attrs[attr.Key] = strings.TrimSpace(attr.Value)
+	return nil
+}
+
+func (e *GraphEdge) Attributes() []encoding.Attribute {
+	var r []encoding.Attribute
+	for k, v := range e.attrs {
+		r = append(r, encoding.Attribute{Key: k, Value: v})
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Key < r[j].Key
+	})
+	return r
+}
+
+// Condition returns the value of the edge's `when` attribute, if any.
+func (e *GraphEdge) Condition() string {
+	return e.attrs[EdgeAttrWhen]
+}
+
 type GraphNode struct {
 	graph.Node
 	dotID string
@@ -233,8 +262,8 @@ func Parse(text string) (*Pipeline, error) {
 			panic("unreachable")
 		}
 
-		if node.dotID == InputTaskKey {
-			return nil, errors.Errorf("'%v' is a reserved keyword that cannot be used as a task's name", InputTaskKey)
+		if node.dotID == InputTaskKey || node.dotID == SecretsVarsKey {
+			return nil, errors.Errorf("'%v' is a reserved keyword that cannot be used as a task's name", node.dotID)
 		}
 
 		task, err := UnmarshalTaskFromMap(TaskType(node.attrs["type"]), node.attrs, id, node.dotID)
@@ -244,11 +273,20 @@ func Parse(text string) (*Pipeline, error) {
 
 		// re-link the edges
 		for inputs := g.To(node.ID()); inputs.Next(); {
-			isImplicitEdge := g.IsImplicitEdge(inputs.Node().ID(), node.ID())
+			edge := g.Edge(inputs.Node().ID(), node.ID()).(*GraphEdge)
 			from := p.Tasks[ids[inputs.Node().ID()]]
 
+			condition := edge.Condition()
+			if condition != "" {
+				if from.Type() != TaskTypeConditional {
+					return nil, errors.Errorf("edge %v -> %v: the '%v' attribute is only allowed on edges out of a conditional task", from.DotID(), task.DotID(), EdgeAttrWhen)
+				} else if condition != "true" && condition != "false" {
+					return nil, errors.Errorf("edge %v -> %v: '%v' must be either true or false, got %q", from.DotID(), task.DotID(), EdgeAttrWhen, condition)
+				}
+			}
+
 			from.Base().outputs = append(from.Base().outputs, task)
-			task.Base().inputs = append(task.Base().inputs, TaskDependency{!isImplicitEdge, from})
+			task.Base().inputs = append(task.Base().inputs, TaskDependency{PropagateResult: !edge.IsImplicit(), InputTask: from, Condition: condition})
 		}
 
 		// This is subtle: g.To doesn't return nodes in deterministic order, which would occasionally swap the order
@@ -241,3 +241,58 @@ func TestGraph_ImplicitDependencies(t *testing.T) {
 	require.True(t, g.HasEdgeFromTo(nodes["b"], nodes["c"]))
 	require.True(t, g.HasEdgeFromTo(nodes["c"], nodes["d"]))
 }
+
+func TestGraph_ConditionalEdges(t *testing.T) {
+	p, err := pipeline.Parse(`
+		a [type=conditional];
+		b [type=memo];
+		c [type=memo];
+		d [type=memo];
+		a -> b [when=true];
+		a -> c [when=false];
+		a -> d;
+	`)
+	require.NoError(t, err)
+
+	for dotID, condition := range map[string]string{"b": "true", "c": "false", "d": ""} {
+		inputs := p.ByDotID(dotID).Inputs()
+		require.Len(t, inputs, 1)
+		require.Equal(t, "a", inputs[0].InputTask.DotID())
+		require.Equal(t, condition, inputs[0].Condition)
+	}
+
+	_, err = pipeline.Parse(`
+		a [type=memo];
+		b [type=memo];
+		a -> b [when=true];
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "only allowed on edges out of a conditional task")
+
+	_, err = pipeline.Parse(`
+		a [type=conditional];
+		b [type=memo];
+		a -> b [when=maybe];
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be either true or false")
+
+	// other edge attributes are ignored
+	p, err = pipeline.Parse(`
+		a [type=memo];
+		b [type=memo];
+		a -> b [color=red];
+	`)
+	require.NoError(t, err)
+	require.Equal(t, "", p.ByDotID("b").Inputs()[0].Condition)
+}
+
+func TestGraph_ReservedTaskNames(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{pipeline.InputTaskKey, pipeline.SecretsVarsKey} {
+		_, err := pipeline.Parse(name + ` [type=memo];`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "reserved keyword")
+	}
+}
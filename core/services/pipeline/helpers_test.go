@@ -1,6 +1,8 @@
 package pipeline
 
 import (
+	"context"
+
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -46,7 +48,31 @@ func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config) {
 	t.config = config
 }
 
+func (t *ETHMultiCallTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
+
+func (t *ETHBalanceTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
+
+func (t *ETHBlockTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
+
+func (t *ETHGetLogsTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
+
+func (t *ETHStorageTask) HelperSetDependencies(cc evm.ChainSet) {
+	t.chainSet = cc
+}
+
 func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore) {
 	t.chainSet = cc
 	t.keyStore = keyStore
 }
+
+func (r *runner) ExpireAsyncTasks(ctx context.Context) {
+	r.expireAsyncTasks(ctx)
+}
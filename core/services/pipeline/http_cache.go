@@ -0,0 +1,126 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// httpCacheMaxEntries bounds the number of responses held by the HTTP response cache
+const httpCacheMaxEntries = 1000
+
+var (
+	promHTTPCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits",
+		Help: "The number of HTTP task responses served from the response cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_misses",
+		Help: "The number of HTTP task requests with caching enabled that were not found in the response cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+)
+
+// httpResponseCache holds successful HTTP task responses in memory for tasks
+// that set cacheTTL. Responses are keyed by every part of the request,
+// including headers, so that requests made with different credentials never
+// share an entry. A cached response is only served to a task that could have
+// made the request itself: responses fetched with unrestricted network access
+// are not served to restricted tasks, nor bodies over the task's size limit.
+type httpResponseCache struct {
+	mu         sync.Mutex
+	entries    map[string]httpCacheEntry
+	maxEntries int
+}
+
+type httpCacheEntry struct {
+	body         []byte
+	unrestricted bool
+	expiresAt    time.Time
+}
+
+var httpCache = newHTTPResponseCache(httpCacheMaxEntries)
+
+func newHTTPResponseCache(maxEntries int) *httpResponseCache {
+	return &httpResponseCache{
+		entries:    make(map[string]httpCacheEntry),
+		maxEntries: maxEntries,
+	}
+}
+
+func httpCacheKey(method StringParam, url URLParam, requestData MapParam, headers map[string]string) (string, error) {
+	headerKeys := make([]string, 0, len(headers))
+	for k := range headers {
+		headerKeys = append(headerKeys, k)
+	}
+	sort.Strings(headerKeys)
+	sortedHeaders := make([][2]string, len(headerKeys))
+	for i, k := range headerKeys {
+		sortedHeaders[i] = [2]string{k, headers[k]}
+	}
+
+	bs, err := json.Marshal([]interface{}{method, url.String(), requestData, sortedHeaders})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(bs)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func (c *httpResponseCache) Get(key string, allowUnrestrictedNetworkAccess bool, sizeLimit int64) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, exists := c.entries[key]
+	if !exists {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	if entry.unrestricted && !allowUnrestrictedNetworkAccess {
+		return nil, false
+	}
+	if int64(len(entry.body)) > sizeLimit {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *httpResponseCache) Set(key string, body []byte, allowUnrestrictedNetworkAccess bool, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	c.entries[key] = httpCacheEntry{body: body, unrestricted: allowUnrestrictedNetworkAccess, expiresAt: time.Now().Add(ttl)}
+}
+
+// evict drops expired entries, or the entry closest to expiry if none have
+// expired yet. Must be called with the lock held.
+func (c *httpResponseCache) evict() {
+	now := time.Now()
+	var oldestKey string
+	var oldest time.Time
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+			continue
+		}
+		if oldestKey == "" || entry.expiresAt.Before(oldest) {
+			oldestKey, oldest = k, entry.expiresAt
+		}
+	}
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,66 @@
+package pipeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPCacheKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := httpCacheKey("GET", URLParam{Scheme: "https", Host: "example.com"}, nil, map[string]string{"A": "1", "B": "2"})
+	require.NoError(t, err)
+	sameKey, err := httpCacheKey("GET", URLParam{Scheme: "https", Host: "example.com"}, nil, map[string]string{"B": "2", "A": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, key, sameKey)
+
+	otherHeaders, err := httpCacheKey("GET", URLParam{Scheme: "https", Host: "example.com"}, nil, map[string]string{"A": "1", "B": "3"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherHeaders)
+	otherMethod, err := httpCacheKey("POST", URLParam{Scheme: "https", Host: "example.com"}, nil, map[string]string{"A": "1", "B": "2"})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherMethod)
+}
+
+func TestHTTPResponseCache(t *testing.T) {
+	t.Parallel()
+
+	c := newHTTPResponseCache(2)
+
+	c.Set("a", []byte("1"), false, time.Hour)
+	body, exists := c.Get("a", false, 10)
+	require.True(t, exists)
+	assert.Equal(t, []byte("1"), body)
+	_, exists = c.Get("a", true, 10)
+	assert.True(t, exists)
+
+	// larger than the size limit
+	_, exists = c.Get("a", false, 0)
+	assert.False(t, exists)
+
+	// fetched with unrestricted network access
+	c.Set("a", []byte("1"), true, time.Hour)
+	_, exists = c.Get("a", false, 10)
+	assert.False(t, exists)
+	_, exists = c.Get("a", true, 10)
+	assert.True(t, exists)
+
+	// expired entries are dropped
+	c.Set("b", []byte("2"), false, 0)
+	time.Sleep(time.Millisecond)
+	_, exists = c.Get("b", false, 10)
+	assert.False(t, exists)
+
+	// the entry closest to expiry is evicted once full
+	c.Set("b", []byte("2"), false, 2*time.Hour)
+	c.Set("c", []byte("3"), false, 3*time.Hour)
+	_, exists = c.Get("a", true, 10)
+	assert.False(t, exists)
+	_, exists = c.Get("b", false, 10)
+	assert.True(t, exists)
+	_, exists = c.Get("c", false, 10)
+	assert.True(t, exists)
+}
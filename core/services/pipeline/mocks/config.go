@@ -102,6 +102,34 @@ func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperExportDir provides a mock function with given fields:
+func (_m *Config) JobPipelineReaperExportDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// JobPipelineReaperFailedThreshold provides a mock function with given fields:
+func (_m *Config) JobPipelineReaperFailedThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *Config) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -116,6 +144,20 @@ func (_m *Config) JobPipelineReaperInterval() time.Duration {
 	return r0
 }
 
+// JobPipelineReaperMaxRuns provides a mock function with given fields:
+func (_m *Config) JobPipelineReaperMaxRuns() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperThreshold provides a mock function with given fields:
 func (_m *Config) JobPipelineReaperThreshold() time.Duration {
 	ret := _m.Called()
@@ -85,18 +85,69 @@ func (_m *ORM) DeleteRun(id int64) error {
 	return r0
 }
 
-// DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
-func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteRuns provides a mock function with given fields: ctx, policy, export
+func (_m *ORM) DeleteRuns(ctx context.Context, policy pipeline.RunRetentionPolicy, export func([]pipeline.Run) error) (int64, error) {
+	ret := _m.Called(ctx, policy, export)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RunRetentionPolicy, func([]pipeline.Run) error) int64); ok {
+		r0 = rf(ctx, policy, export)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RunRetentionPolicy, func([]pipeline.Run) error) error); ok {
+		r1 = rf(ctx, policy, export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindExpiredTaskRunIDs provides a mock function with given fields: ctx, now
+func (_m *ORM) FindExpiredTaskRunIDs(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []uuid.UUID); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindLatestCompletedRun provides a mock function with given fields: pipelineSpecID
+func (_m *ORM) FindLatestCompletedRun(pipelineSpecID int32) (pipeline.Run, error) {
+	ret := _m.Called(pipelineSpecID)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(int32) pipeline.Run); ok {
+		r0 = rf(pipelineSpecID)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(pipelineSpecID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // FindRun provides a mock function with given fields: id
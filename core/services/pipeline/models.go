@@ -49,6 +49,8 @@ type Run struct {
 	FinishedAt       null.Time        `json:"finishedAt"`
 	PipelineTaskRuns []TaskRun        `json:"taskRuns"`
 	State            RunStatus        `json:"state"`
+	// RerunOf is the ID of the run this run re-executed, if any.
+	RerunOf null.Int `json:"rerunOf"`
 
 	Pending   bool
 	FailEarly bool
@@ -250,6 +252,10 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	Skipped       bool             `json:"skipped"`
+	Details       JSONSerializable `json:"details"`
+	// ExpiresAt is when a pending async task run times out, if it does
+	ExpiresAt null.Time `json:"expiresAt"`
 
 	// Used internally for sorting completed results
 	task Task
@@ -27,9 +27,11 @@ type ORM interface {
 	DeleteRun(id int64) error
 	StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
+	FindExpiredTaskRunIDs(ctx context.Context, now time.Time) ([]uuid.UUID, error)
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
-	DeleteRunsOlderThan(context.Context, time.Duration) error
+	DeleteRuns(ctx context.Context, policy RunRetentionPolicy, export func([]Run) error) (deleted int64, err error)
 	FindRun(id int64) (Run, error)
+	FindLatestCompletedRun(pipelineSpecID int32) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
 	GetQ() pg.Q
@@ -89,8 +91,8 @@ func (o *orm) CreateRun(run *Run, qopts ...pg.QOpt) (err error) {
 // InsertRun inserts a run into the database
 func (o *orm) InsertRun(run *Run, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
-	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+	sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, rerun_of)
+		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :rerun_of)
 		RETURNING *;`
 	return q.GetNamed(sql, run, run)
 }
@@ -152,10 +154,10 @@ func (o *orm) StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error) {
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped, details, expires_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped, :details, :expires_at)
 		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at, skipped = EXCLUDED.skipped, details = EXCLUDED.details, expires_at = EXCLUDED.expires_at
 		RETURNING *;
 		`
 
@@ -191,7 +193,7 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 		FROM pipeline_runs
 		JOIN pipeline_task_runs ON (pipeline_task_runs.pipeline_run_id = pipeline_runs.id)
 		JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
-		WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended')
+		WHERE pipeline_task_runs.id = $1 AND pipeline_task_runs.finished_at IS NULL AND pipeline_runs.state in ('running', 'suspended')
 		FOR UPDATE`
 		if err = tx.Get(&run, sql, taskID); err != nil {
 			return err
@@ -225,6 +227,17 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 	return run, start, err
 }
 
+// FindExpiredTaskRunIDs returns the IDs of the pending task runs of suspended
+// runs that have not been resumed before their expiry.
+func (o *orm) FindExpiredTaskRunIDs(ctx context.Context, now time.Time) (ids []uuid.UUID, err error) {
+	sql := `SELECT pipeline_task_runs.id FROM pipeline_task_runs
+	JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
+	WHERE pipeline_runs.state = 'suspended' AND pipeline_task_runs.finished_at IS NULL AND pipeline_task_runs.expires_at < $1
+	ORDER BY pipeline_task_runs.expires_at ASC`
+	err = o.q.WithOpts(pg.WithParentCtx(ctx)).Select(&ids, sql, now)
+	return ids, errors.Wrap(err, "FindExpiredTaskRunIDs failed")
+}
+
 // If saveSuccessfulTaskRuns = false, we only save errored runs.
 // That way if the job is run frequently (such as OCR) we avoid saving a large number of successful task runs
 // which do not provide much value.
@@ -244,8 +257,8 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 
 	q := o.q.WithOpts(qopts...)
 	err = q.Transaction(func(tx pg.Queryer) error {
-		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
-		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state, rerun_of)
+		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state, :rerun_of)
 		RETURNING id;`
 
 		query, args, e := tx.BindNamed(sql, run)
@@ -267,18 +280,181 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 		}
 
 		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at, skipped, details)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at, :skipped, :details);`
 		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
 		return errors.Wrap(err, "failed to insert pipeline_task_runs")
 	})
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
-func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
+// RunRetentionPolicy controls which finished runs are deleted by the reaper.
+// Jobs can override each limit with their runRetention* fields.
+type RunRetentionPolicy struct {
+	// MaxAge is how long runs are kept after they finish.
+	MaxAge time.Duration
+	// MaxFailedAge is how long errored runs are kept after they finish.
+	// Defaults to MaxAge if zero.
+	MaxFailedAge time.Duration
+	// MaxRuns is the maximum number of completed runs kept per job: older
+	// completed runs are deleted, in addition to the runs older than MaxAge.
+	// Zero means no limit.
+	MaxRuns uint32
+}
+
+const deleteRunsBatchSize = 1000
+
+// jobRunRetention is the retention policy of a job, with its overrides
+// applied over the node's policy.
+type jobRunRetention struct {
+	PipelineSpecID int32           `db:"pipeline_spec_id"`
+	MaxAge         models.Interval `db:"run_retention_max_age"`
+	MaxFailedAge   models.Interval `db:"run_retention_max_failed_age"`
+	MaxRuns        uint32          `db:"run_retention_max_runs"`
+}
+
+// hasAgeOverride returns true if the job keeps its runs for a different time
+// than the node's policy.
+func (r jobRunRetention) hasAgeOverride() bool {
+	return !r.MaxAge.IsZero() || !r.MaxFailedAge.IsZero()
+}
+
+// applyDefaults fills in the limits the job does not override from policy.
+func (r jobRunRetention) applyDefaults(policy RunRetentionPolicy) RunRetentionPolicy {
+	applied := RunRetentionPolicy{
+		MaxAge:       r.MaxAge.Duration(),
+		MaxFailedAge: r.MaxFailedAge.Duration(),
+		MaxRuns:      r.MaxRuns,
+	}
+	if applied.MaxAge == 0 {
+		applied.MaxAge = policy.MaxAge
+	}
+	if applied.MaxFailedAge == 0 {
+		applied.MaxFailedAge = policy.MaxFailedAge
+	}
+	if applied.MaxRuns == 0 {
+		applied.MaxRuns = policy.MaxRuns
+	}
+	return applied
+}
+
+// failedAge returns how long errored runs are kept.
+func (p RunRetentionPolicy) failedAge() time.Duration {
+	if p.MaxFailedAge == 0 {
+		return p.MaxAge
+	}
+	return p.MaxFailedAge
+}
+
+// DeleteRuns deletes the finished runs that fall outside of policy, or outside
+// of the retention overrides of the job they belong to, in batches. If export
+// is given, it is called with each batch of runs (including their task runs)
+// before the batch is deleted, and an export error stops the deletion.
+//
+// Runs are deleted by age first, with one range query on finished_at for the
+// jobs following the node's policy and one per job overriding it. The cutoff
+// of each job limited to a number of runs is then looked up once.
+func (o *orm) DeleteRuns(ctx context.Context, policy RunRetentionPolicy, export func([]Run) error) (deleted int64, err error) {
 	q := o.q.WithOpts(pg.WithParentCtx(ctx))
-	err := q.ExecQ(`DELETE FROM pipeline_runs WHERE finished_at < $1`, time.Now().Add(-threshold))
-	return errors.Wrap(err, "DeleteRunsOlderThan failed")
+
+	var jobs []jobRunRetention
+	err = q.Select(&jobs, `SELECT pipeline_spec_id, run_retention_max_age, run_retention_max_failed_age, run_retention_max_runs FROM jobs
+		WHERE run_retention_max_age > 0 OR run_retention_max_failed_age > 0 OR run_retention_max_runs > 0 OR $1::boolean`, policy.MaxRuns > 0)
+	if err != nil {
+		return 0, errors.Wrap(err, "DeleteRuns failed to load job retention policies")
+	}
+
+	now := time.Now()
+	overridden := []int32{}
+	for _, job := range jobs {
+		if !job.hasAgeOverride() {
+			continue
+		}
+		overridden = append(overridden, job.PipelineSpecID)
+		jobPolicy := job.applyDefaults(policy)
+		n, err := o.deleteRunBatches(q, export, `SELECT id FROM pipeline_runs WHERE pipeline_spec_id = $1
+			AND ((state <> 'errored' AND finished_at < $2) OR (state = 'errored' AND finished_at < $3))
+			LIMIT $4`, job.PipelineSpecID, now.Add(-jobPolicy.MaxAge), now.Add(-jobPolicy.failedAge()))
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+	n, err := o.deleteRunBatches(q, export, `SELECT id FROM pipeline_runs WHERE pipeline_spec_id <> ALL($1)
+		AND ((state <> 'errored' AND finished_at < $2) OR (state = 'errored' AND finished_at < $3))
+		LIMIT $4`, overridden, now.Add(-policy.MaxAge), now.Add(-policy.failedAge()))
+	deleted += n
+	if err != nil {
+		return deleted, err
+	}
+
+	for _, job := range jobs {
+		maxRuns := job.applyDefaults(policy).MaxRuns
+		if maxRuns == 0 {
+			continue
+		}
+		// The newest completed run over the limit, it and the older ones are deleted
+		var cutoff []int64
+		err = q.Select(&cutoff, `SELECT id FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = 'completed'
+			ORDER BY id DESC OFFSET $2 LIMIT 1`, job.PipelineSpecID, maxRuns)
+		if err != nil {
+			return deleted, errors.Wrap(err, "DeleteRuns failed to select max runs cutoff")
+		}
+		if len(cutoff) == 0 {
+			continue
+		}
+		n, err := o.deleteRunBatches(q, export, `SELECT id FROM pipeline_runs WHERE pipeline_spec_id = $1
+			AND state = 'completed' AND id <= $2
+			LIMIT $3`, job.PipelineSpecID, cutoff[0])
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// deleteRunBatches deletes the runs selected by query until there are none
+// left. The batch size is passed as the last argument of query.
+func (o *orm) deleteRunBatches(q pg.Q, export func([]Run) error, query string, args ...interface{}) (deleted int64, err error) {
+	args = append(args, deleteRunsBatchSize)
+	for {
+		var ids []int64
+		if err = q.Select(&ids, query, args...); err != nil {
+			return deleted, errors.Wrap(err, "DeleteRuns failed to select runs")
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		if export != nil {
+			var runs []Run
+			err = q.Transaction(func(tx pg.Queryer) error {
+				if err = tx.Select(&runs, `SELECT * FROM pipeline_runs WHERE id = ANY($1) ORDER BY id ASC`, ids); err != nil {
+					return errors.Wrap(err, "failed to load runs")
+				}
+				return loadAssociations(tx, runs)
+			})
+			if err != nil {
+				return deleted, errors.Wrap(err, "DeleteRuns failed to load runs for export")
+			}
+			if err = export(runs); err != nil {
+				return deleted, errors.Wrap(err, "DeleteRuns failed to export runs")
+			}
+		}
+
+		res, cancel, err := q.ExecQIter(`DELETE FROM pipeline_runs WHERE id = ANY($1)`, ids)
+		if err != nil {
+			cancel()
+			return deleted, errors.Wrap(err, "DeleteRuns failed to delete runs")
+		}
+		rowsAffected, err := res.RowsAffected()
+		cancel()
+		if err != nil {
+			return deleted, errors.Wrap(err, "DeleteRuns failed to delete runs")
+		}
+		deleted += rowsAffected
+	}
 }
 
 func (o *orm) FindRun(id int64) (r Run, err error) {
@@ -295,6 +471,17 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 	return runs[0], err
 }
 
+// FindLatestCompletedRun returns the latest successful run of the pipeline
+// spec, without its task runs, or sql.ErrNoRows if there is none. Runs are
+// ordered by their jobRun.scheduledTimestamp input, since concurrent runs can
+// finish or even be created out of order, then by creation time for runs
+// without one.
+func (o *orm) FindLatestCompletedRun(pipelineSpecID int32) (r Run, err error) {
+	err = o.q.Get(&r, `SELECT * FROM pipeline_runs WHERE pipeline_spec_id = $1 AND state = $2
+		ORDER BY (inputs->'jobRun'->>'scheduledTimestamp')::numeric DESC NULLS LAST, created_at DESC, id DESC LIMIT 1`, pipelineSpecID, RunStatusCompleted)
+	return r, err
+}
+
 func (o *orm) GetAllRuns() (runs []Run, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		err = tx.Select(&runs, `SELECT * from pipeline_runs ORDER BY created_at ASC, id ASC`)
@@ -1,9 +1,13 @@
 package pipeline_test
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +15,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -308,6 +313,62 @@ func Test_PipelineORM_StoreRun_UpdateTaskRunResult(t *testing.T) {
 	require.Equal(t, pipeline.JSONSerializable{Val: "foo", Valid: true}, task.Output)
 }
 
+func Test_PipelineORM_FindExpiredTaskRunIDs(t *testing.T) {
+	_, orm := setupORM(t)
+
+	run := mustInsertAsyncRun(t, orm)
+
+	now := time.Now()
+
+	expiredID := uuid.NewV4()
+	run.PipelineTaskRuns = []pipeline.TaskRun{
+		// pending task past its expiry
+		{
+			ID:            expiredID,
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds1",
+			CreatedAt:     now.Add(-time.Hour),
+			ExpiresAt:     null.TimeFrom(now.Add(-time.Minute)),
+		},
+		// pending task not yet expired
+		{
+			ID:            uuid.NewV4(),
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds2",
+			CreatedAt:     now,
+			ExpiresAt:     null.TimeFrom(now.Add(time.Hour)),
+		},
+		// pending task without a timeout
+		{
+			ID:            uuid.NewV4(),
+			PipelineRunID: run.ID,
+			Type:          "bridge",
+			DotID:         "ds3",
+			CreatedAt:     now.Add(-time.Hour),
+		},
+	}
+	_, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	ids, err := orm.FindExpiredTaskRunIDs(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{expiredID}, ids)
+
+	_, _, err = orm.UpdateTaskRunResult(expiredID, pipeline.Result{Error: pipeline.ErrAsyncTaskTimeout})
+	require.NoError(t, err)
+
+	ids, err = orm.FindExpiredTaskRunIDs(context.Background(), now)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// A finished task cannot be resumed again
+	_, _, err = orm.UpdateTaskRunResult(expiredID, pipeline.Result{Value: "foo"})
+	assert.True(t, errors.Is(err, sql.ErrNoRows), "unexpected error %v", err)
+}
+
 func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, orm := setupORM(t)
 
@@ -349,3 +410,155 @@ func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, err = orm.FindRun(run.ID)
 	require.Error(t, err, "not found")
 }
+
+func mustInsertFinishedRun(t *testing.T, orm pipeline.ORM, specID int32, state pipeline.RunStatus, finishedAt time.Time) pipeline.Run {
+	t.Helper()
+
+	run := pipeline.Run{
+		PipelineSpecID: specID,
+		State:          state,
+		Outputs:        pipeline.JSONSerializable{},
+		AllErrors:      pipeline.RunErrors{},
+		FatalErrors:    pipeline.RunErrors{},
+		CreatedAt:      finishedAt,
+		FinishedAt:     null.TimeFrom(finishedAt),
+		PipelineTaskRuns: []pipeline.TaskRun{{
+			ID:         uuid.NewV4(),
+			Type:       pipeline.TaskTypeAny,
+			DotID:      "ds1",
+			Output:     pipeline.JSONSerializable{Val: 1, Valid: true},
+			CreatedAt:  finishedAt,
+			FinishedAt: null.TimeFrom(finishedAt),
+		}},
+	}
+	require.NoError(t, orm.InsertFinishedRun(&run, true))
+	return run
+}
+
+func Test_PipelineORM_FindLatestCompletedRun(t *testing.T) {
+	db, orm := setupORM(t)
+
+	p, err := pipeline.Parse(`ds1 [type=any]`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, 0)
+	require.NoError(t, err)
+
+	_, err = orm.FindLatestCompletedRun(specID)
+	require.Equal(t, sql.ErrNoRows, errors.Cause(err))
+
+	now := time.Now()
+	withoutSchedule := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now)
+	// Created out of order of their scheduled times
+	later := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-time.Minute))
+	earlier := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-time.Minute))
+	mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusErrored, now.Add(-time.Minute))
+	for id, scheduledAt := range map[int64]time.Time{later.ID: now.Add(-2 * time.Hour), earlier.ID: now.Add(-3 * time.Hour)} {
+		_, err = db.Exec(`UPDATE pipeline_runs SET inputs = $1 WHERE id = $2`,
+			fmt.Sprintf(`{"jobRun": {"scheduledTimestamp": %d}}`, scheduledAt.Unix()), id)
+		require.NoError(t, err)
+	}
+
+	run, err := orm.FindLatestCompletedRun(specID)
+	require.NoError(t, err)
+	assert.Equal(t, later.ID, run.ID)
+
+	_, err = db.Exec(`DELETE FROM pipeline_runs WHERE id = ANY($1)`, []int64{later.ID, earlier.ID})
+	require.NoError(t, err)
+	run, err = orm.FindLatestCompletedRun(specID)
+	require.NoError(t, err)
+	assert.Equal(t, withoutSchedule.ID, run.ID)
+}
+
+func Test_PipelineORM_DeleteRuns(t *testing.T) {
+	db, orm := setupORM(t)
+
+	p, err := pipeline.Parse(`ds1 [type=any]`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, 0)
+	require.NoError(t, err)
+	jobSpecID, err := orm.CreateSpec(*p, 0)
+	require.NoError(t, err)
+
+	// A job keeping only its 2 latest completed runs
+	var webhookSpecID int32
+	require.NoError(t, db.Get(&webhookSpecID, `INSERT INTO webhook_specs (created_at, updated_at) VALUES (NOW(), NOW()) RETURNING id`))
+	_, err = db.Exec(`INSERT INTO jobs (pipeline_spec_id, name, schema_version, type, max_task_duration, webhook_spec_id, external_job_id, run_retention_max_runs, created_at)
+		VALUES ($1, 'retention', 1, 'webhook', 0, $2, $3, 2, NOW())`, jobSpecID, webhookSpecID, uuid.NewV4())
+	require.NoError(t, err)
+
+	now := time.Now()
+	oldCompleted := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+	recentErrored := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusErrored, now.Add(-2*time.Hour))
+	oldErrored := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusErrored, now.Add(-4*time.Hour))
+	recentCompleted := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-10*time.Minute))
+	jobRuns := []pipeline.Run{
+		mustInsertFinishedRun(t, orm, jobSpecID, pipeline.RunStatusCompleted, now.Add(-30*time.Minute)),
+		mustInsertFinishedRun(t, orm, jobSpecID, pipeline.RunStatusCompleted, now.Add(-20*time.Minute)),
+		mustInsertFinishedRun(t, orm, jobSpecID, pipeline.RunStatusCompleted, now.Add(-10*time.Minute)),
+	}
+	_, err = db.Exec(`SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`)
+	require.NoError(t, err)
+	unfinished := mustInsertPipelineRun(t, orm)
+
+	var exported []pipeline.Run
+	deleted, err := orm.DeleteRuns(testutils.Context(t), pipeline.RunRetentionPolicy{
+		MaxAge:       time.Hour,
+		MaxFailedAge: 3 * time.Hour,
+	}, func(runs []pipeline.Run) error {
+		exported = append(exported, runs...)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	var exportedIDs []int64
+	for _, run := range exported {
+		exportedIDs = append(exportedIDs, run.ID)
+		assert.Len(t, run.PipelineTaskRuns, 1)
+	}
+	assert.ElementsMatch(t, []int64{oldCompleted.ID, oldErrored.ID, jobRuns[0].ID}, exportedIDs)
+
+	var remaining []int64
+	require.NoError(t, db.Select(&remaining, `SELECT id FROM pipeline_runs ORDER BY id`))
+	assert.ElementsMatch(t, []int64{recentErrored.ID, recentCompleted.ID, jobRuns[1].ID, jobRuns[2].ID, unfinished.ID}, remaining)
+
+	t.Run("does not delete runs if export fails", func(t *testing.T) {
+		_, err := orm.DeleteRuns(testutils.Context(t), pipeline.RunRetentionPolicy{MaxAge: time.Minute}, func([]pipeline.Run) error {
+			return errors.New("disk full")
+		})
+		require.EqualError(t, err, "DeleteRuns failed to export runs: disk full")
+
+		var count int
+		require.NoError(t, db.Get(&count, `SELECT count(*) FROM pipeline_runs`))
+		assert.Equal(t, 5, count)
+	})
+
+	t.Run("deletes runs older than the max age even if they are among the max runs", func(t *testing.T) {
+		deleted, err := orm.DeleteRuns(testutils.Context(t), pipeline.RunRetentionPolicy{
+			MaxAge:       15 * time.Minute,
+			MaxFailedAge: 3 * time.Hour,
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+
+		var remaining []int64
+		require.NoError(t, db.Select(&remaining, `SELECT id FROM pipeline_runs ORDER BY id`))
+		assert.ElementsMatch(t, []int64{recentErrored.ID, recentCompleted.ID, jobRuns[2].ID, unfinished.ID}, remaining)
+	})
+
+	t.Run("deletes runs older than the max age of their job", func(t *testing.T) {
+		_, err := db.Exec(`UPDATE jobs SET run_retention_max_age = $1 WHERE pipeline_spec_id = $2`, time.Minute.Nanoseconds(), jobSpecID)
+		require.NoError(t, err)
+
+		deleted, err := orm.DeleteRuns(testutils.Context(t), pipeline.RunRetentionPolicy{
+			MaxAge:       time.Hour,
+			MaxFailedAge: 3 * time.Hour,
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+
+		var remaining []int64
+		require.NoError(t, db.Select(&remaining, `SELECT id FROM pipeline_runs ORDER BY id`))
+		assert.ElementsMatch(t, []int64{recentErrored.ID, recentCompleted.ID, unfinished.ID}, remaining)
+	})
+}
@@ -0,0 +1,184 @@
+package pipeline
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+)
+
+const (
+	outlierReasonMAD          = "mad"
+	outlierReasonIQR          = "iqr"
+	outlierReasonMaxDeviation = "maxDeviation"
+)
+
+// outlierFilter drops values that deviate too far from the rest before they
+// are aggregated. A value is dropped if any of the configured criteria flags
+// it, all of which are computed over the full set of values:
+//
+//   - mad: more than mad median absolute deviations away from the median
+//   - iqr: more than iqr interquartile ranges below the first or above the
+//     third quartile
+//   - maxDeviation: further from the median than maxDeviation times the
+//     median, e.g. 0.05 for 5%
+//
+// The MAD and IQR criteria are ignored if the deviation or range is zero,
+// i.e. if most values agree exactly. At least minSources values (or 1, if not
+// set) must remain after filtering.
+type outlierFilter struct {
+	mad          *decimal.Decimal
+	iqr          *decimal.Decimal
+	maxDeviation *decimal.Decimal
+	minSources   int
+}
+
+type droppedOutlier struct {
+	Index   int             `json:"index"`
+	Value   decimal.Decimal `json:"value"`
+	Reasons []string        `json:"reasons"`
+}
+
+func resolveOutlierFilter(vars Vars, mad, iqr, maxDeviation, minSources string) (f outlierFilter, err error) {
+	var maybeMinSources MaybeUint64Param
+	err = multierr.Combine(
+		errors.Wrap(resolveMaybeNonNegativeDecimal(&f.mad, mad, vars), "outlierMAD"),
+		errors.Wrap(resolveMaybeNonNegativeDecimal(&f.iqr, iqr, vars), "outlierIQR"),
+		errors.Wrap(resolveMaybeNonNegativeDecimal(&f.maxDeviation, maxDeviation, vars), "maxDeviation"),
+		errors.Wrap(ResolveParam(&maybeMinSources, From(VarExpr(minSources, vars), minSources)), "minSources"),
+	)
+	if n, isSet := maybeMinSources.Uint64(); isSet {
+		f.minSources = int(n)
+	}
+	return f, err
+}
+
+func resolveMaybeNonNegativeDecimal(out **decimal.Decimal, s string, vars Vars) error {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	var d DecimalParam
+	if err := ResolveParam(&d, From(VarExpr(s, vars), NonemptyString(s))); err != nil {
+		return err
+	} else if d.Decimal().IsNegative() {
+		return errors.Wrapf(ErrBadInput, "must not be negative, got %v", d.Decimal())
+	}
+	val := d.Decimal()
+	*out = &val
+	return nil
+}
+
+func (f outlierFilter) enabled() bool {
+	return f.mad != nil || f.iqr != nil || f.maxDeviation != nil || f.minSources > 0
+}
+
+// apply returns the values that are not outliers, and details listing the
+// dropped ones. indices are the positions of values in the task's input, and
+// are used to identify dropped values in the details.
+func (f outlierFilter) apply(values []decimal.Decimal, indices []int) ([]decimal.Decimal, map[string]interface{}, error) {
+	if !f.enabled() {
+		return values, nil, nil
+	}
+
+	median := medianOf(values)
+	deviations := make([]decimal.Decimal, len(values))
+	for i, val := range values {
+		deviations[i] = val.Sub(median).Abs()
+	}
+
+	var madLimit, iqrLow, iqrHigh, maxDeviationLimit *decimal.Decimal
+	if f.mad != nil {
+		if mad := medianOf(deviations); mad.IsPositive() {
+			limit := mad.Mul(*f.mad)
+			madLimit = &limit
+		}
+	}
+	if f.iqr != nil {
+		sorted := sortedDecimals(values)
+		q1, q3 := quantileOf(sorted, decimal.NewFromFloat(0.25)), quantileOf(sorted, decimal.NewFromFloat(0.75))
+		if iqr := q3.Sub(q1); iqr.IsPositive() {
+			low, high := q1.Sub(iqr.Mul(*f.iqr)), q3.Add(iqr.Mul(*f.iqr))
+			iqrLow, iqrHigh = &low, &high
+		}
+	}
+	if f.maxDeviation != nil && !median.IsZero() {
+		limit := median.Abs().Mul(*f.maxDeviation)
+		maxDeviationLimit = &limit
+	}
+
+	kept := make([]decimal.Decimal, 0, len(values))
+	dropped := []droppedOutlier{}
+	for i, val := range values {
+		var reasons []string
+		if madLimit != nil && deviations[i].GreaterThan(*madLimit) {
+			reasons = append(reasons, outlierReasonMAD)
+		}
+		if iqrLow != nil && (val.LessThan(*iqrLow) || val.GreaterThan(*iqrHigh)) {
+			reasons = append(reasons, outlierReasonIQR)
+		}
+		if maxDeviationLimit != nil && deviations[i].GreaterThan(*maxDeviationLimit) {
+			reasons = append(reasons, outlierReasonMaxDeviation)
+		}
+		if len(reasons) > 0 {
+			dropped = append(dropped, droppedOutlier{Index: indices[i], Value: val, Reasons: reasons})
+		} else {
+			kept = append(kept, val)
+		}
+	}
+
+	details := map[string]interface{}{
+		"median":  median,
+		"dropped": dropped,
+	}
+	minSources := f.minSources
+	if minSources < 1 {
+		minSources = 1
+	}
+	if len(kept) < minSources {
+		return nil, details, errors.Wrapf(ErrWrongInputCardinality, "%v values left after dropping %v outliers, need at least %v", len(kept), len(dropped), minSources)
+	}
+	return kept, details, nil
+}
+
+// nonErrorIndices returns the positions of the values in s that are not
+// errors, matching the values returned by s.FilterErrors.
+func nonErrorIndices(s SliceParam) []int {
+	var indices []int
+	for i, x := range s {
+		if _, is := x.(error); !is {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func sortedDecimals(values []decimal.Decimal) []decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	return sorted
+}
+
+func medianOf(values []decimal.Decimal) decimal.Decimal {
+	sorted := sortedDecimals(values)
+	k := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[k]
+	}
+	return sorted[k].Add(sorted[k-1]).Div(decimal.NewFromInt(2))
+}
+
+// quantileOf interpolates linearly between the closest ranks of sorted
+func quantileOf(sorted []decimal.Decimal, q decimal.Decimal) decimal.Decimal {
+	pos := q.Mul(decimal.NewFromInt(int64(len(sorted) - 1)))
+	lower := pos.Floor()
+	i := int(lower.IntPart())
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i].Add(sorted[i+1].Sub(sorted[i]).Mul(pos.Sub(lower)))
+}
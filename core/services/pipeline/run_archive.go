@@ -0,0 +1,112 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// archivedRun is the representation of a run in a run archive. Unlike Run,
+// it includes the IDs needed to relate it back to its job.
+type archivedRun struct {
+	ID             int64            `json:"id"`
+	PipelineSpecID int32            `json:"pipelineSpecID"`
+	State          RunStatus        `json:"state"`
+	Meta           JSONSerializable `json:"meta"`
+	AllErrors      RunErrors        `json:"allErrors"`
+	FatalErrors    RunErrors        `json:"fatalErrors"`
+	Inputs         JSONSerializable `json:"inputs"`
+	Outputs        JSONSerializable `json:"outputs"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	FinishedAt     null.Time        `json:"finishedAt"`
+	TaskRuns       []TaskRun        `json:"taskRuns"`
+}
+
+// runArchive writes runs to a gzip compressed JSON lines file in dir, one run
+// per line. The file is created on the first write, so that reaper passes
+// that delete nothing leave no empty archives behind.
+type runArchive struct {
+	dir  string
+	path string
+	file *os.File
+	gz   *gzip.Writer
+}
+
+func newRunArchive(dir string) *runArchive {
+	return &runArchive{dir: dir}
+}
+
+// Write appends runs to the archive, and syncs it to disk before returning so
+// that runs are never deleted before they have been archived.
+func (a *runArchive) Write(runs []Run) error {
+	if a.file == nil {
+		if err := a.open(); err != nil {
+			return err
+		}
+	}
+
+	enc := json.NewEncoder(a.gz)
+	for _, run := range runs {
+		err := enc.Encode(archivedRun{
+			ID:             run.ID,
+			PipelineSpecID: run.PipelineSpecID,
+			State:          run.State,
+			Meta:           run.Meta,
+			AllErrors:      run.AllErrors,
+			FatalErrors:    run.FatalErrors,
+			Inputs:         run.Inputs,
+			Outputs:        run.Outputs,
+			CreatedAt:      run.CreatedAt,
+			FinishedAt:     run.FinishedAt,
+			TaskRuns:       run.PipelineTaskRuns,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to encode run %v", run.ID)
+		}
+	}
+	if err := a.gz.Flush(); err != nil {
+		return errors.Wrapf(err, "failed to write run archive %s", a.path)
+	}
+	return errors.Wrapf(a.file.Sync(), "failed to sync run archive %s", a.path)
+}
+
+func (a *runArchive) open() error {
+	if err := utils.EnsureDirAndMaxPerms(a.dir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to create run archive directory %s", a.dir)
+	}
+	a.path = filepath.Join(a.dir, fmt.Sprintf("pipeline_runs_%s.jsonl.gz", time.Now().UTC().Format("20060102T150405.000000000Z")))
+	file, err := os.OpenFile(a.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to create run archive")
+	}
+	a.file = file
+	a.gz = gzip.NewWriter(file)
+	return nil
+}
+
+// Path returns the path of the archive file, or an empty string if nothing
+// has been written yet.
+func (a *runArchive) Path() string {
+	return a.path
+}
+
+// Close finishes the archive. It is a no-op if nothing has been written.
+func (a *runArchive) Close() error {
+	if a.file == nil {
+		return nil
+	}
+	return multierr.Combine(
+		a.gz.Close(),
+		a.file.Sync(),
+		a.file.Close(),
+	)
+}
@@ -0,0 +1,78 @@
+package pipeline
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestRunArchive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writes runs as gzipped JSON lines", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "archive")
+		archive := newRunArchive(dir)
+
+		now := time.Now()
+		require.NoError(t, archive.Write([]Run{
+			{ID: 1, PipelineSpecID: 10, State: RunStatusCompleted, CreatedAt: now, FinishedAt: null.TimeFrom(now), PipelineTaskRuns: []TaskRun{
+				{ID: uuid.NewV4(), Type: TaskTypeAny, DotID: "ds1", Output: JSONSerializable{Val: "1", Valid: true}},
+			}},
+		}))
+		require.NoError(t, archive.Write([]Run{
+			{ID: 2, PipelineSpecID: 10, State: RunStatusErrored, FatalErrors: RunErrors{null.StringFrom("boom")}},
+		}))
+		require.NoError(t, archive.Close())
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, archive.Path(), filepath.Join(dir, files[0].Name()))
+		assert.Regexp(t, `^pipeline_runs_.*\.jsonl\.gz$`, files[0].Name())
+
+		f, err := os.Open(archive.Path())
+		require.NoError(t, err)
+		defer f.Close()
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+
+		var runs []map[string]interface{}
+		scanner := bufio.NewScanner(gz)
+		for scanner.Scan() {
+			var run map[string]interface{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &run))
+			runs = append(runs, run)
+		}
+		require.NoError(t, scanner.Err())
+
+		require.Len(t, runs, 2)
+		assert.Equal(t, float64(1), runs[0]["id"])
+		assert.Equal(t, float64(10), runs[0]["pipelineSpecID"])
+		assert.Equal(t, "completed", runs[0]["state"])
+		require.Len(t, runs[0]["taskRuns"], 1)
+		assert.Equal(t, "ds1", runs[0]["taskRuns"].([]interface{})[0].(map[string]interface{})["dotId"])
+		assert.Equal(t, float64(2), runs[1]["id"])
+		assert.Equal(t, "errored", runs[1]["state"])
+		assert.Equal(t, []interface{}{"boom"}, runs[1]["fatalErrors"])
+	})
+
+	t.Run("creates no file if nothing is written", func(t *testing.T) {
+		dir := t.TempDir()
+		archive := newRunArchive(dir)
+		require.NoError(t, archive.Close())
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+		assert.Empty(t, archive.Path())
+	})
+}
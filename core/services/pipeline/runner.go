@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"sort"
 	"sync"
@@ -11,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"go.uber.org/multierr"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -53,6 +55,8 @@ type runner struct {
 	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	secretsKeyStore SecretsKeyStore
+	csaKeyStore     CSAKeyStore
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
 
@@ -94,17 +98,19 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, secretsks SecretsKeyStore, csaks CSAKeyStore, lggr logger.Logger) *runner {
 	r := &runner{
-		orm:         orm,
-		config:      config,
-		chainSet:    chainSet,
-		ethKeyStore: ethks,
-		vrfKeyStore: vrfks,
-		chStop:      make(chan struct{}),
-		wgDone:      sync.WaitGroup{},
-		runFinished: func(*Run) {},
-		lggr:        lggr.Named("PipelineRunner"),
+		orm:             orm,
+		config:          config,
+		chainSet:        chainSet,
+		ethKeyStore:     ethks,
+		vrfKeyStore:     vrfks,
+		secretsKeyStore: secretsks,
+		csaKeyStore:     csaks,
+		chStop:          make(chan struct{}),
+		wgDone:          sync.WaitGroup{},
+		runFinished:     func(*Run) {},
+		lggr:            lggr.Named("PipelineRunner"),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -114,9 +120,10 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 
 func (r *runner) Start() error {
 	return r.StartOnce("PipelineRunner", func() error {
-		r.wgDone.Add(2)
+		r.wgDone.Add(3)
 		go r.scheduleUnfinishedRuns()
 		go r.runReaperLoop()
+		go r.asyncTaskExpiryLoop()
 		return nil
 	})
 }
@@ -230,9 +237,20 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.GetQ()
+			task.(*BridgeTask).csaKeyStore = r.csaKeyStore
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
+		case TaskTypeETHMultiCall:
+			task.(*ETHMultiCallTask).chainSet = r.chainSet
+		case TaskTypeETHBalance:
+			task.(*ETHBalanceTask).chainSet = r.chainSet
+		case TaskTypeETHBlock:
+			task.(*ETHBlockTask).chainSet = r.chainSet
+		case TaskTypeETHGetLogs:
+			task.(*ETHGetLogsTask).chainSet = r.chainSet
+		case TaskTypeETHStorage:
+			task.(*ETHStorageTask).chainSet = r.chainSet
 		case TaskTypeVRF:
 			task.(*VRFTask).keyStore = r.vrfKeyStore
 		case TaskTypeVRFV2:
@@ -264,6 +282,12 @@ func (r *runner) run(
 ) (TaskRunResults, error) {
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
+	vars, redactor, err := r.withSecrets(run.PipelineSpec, vars)
+	if err != nil {
+		return nil, err
+	}
+	l = redactor.Logger(l)
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -315,17 +339,24 @@ func (r *runner) run(
 	// Update run results
 	run.PipelineTaskRuns = nil
 	for _, result := range scheduler.results {
-		output := result.Result.OutputDB()
+		redacted := redactor.Result(result.Result)
+		var expiresAt null.Time
+		if result.runInfo.IsPending && result.runInfo.PendingTimeout > 0 {
+			expiresAt = null.TimeFrom(result.CreatedAt.Add(result.runInfo.PendingTimeout))
+		}
 		run.PipelineTaskRuns = append(run.PipelineTaskRuns, TaskRun{
 			ID:            result.ID,
 			PipelineRunID: run.ID,
 			Type:          result.Task.Type(),
 			Index:         result.Task.OutputIndex(),
-			Output:        output,
-			Error:         result.Result.ErrorDB(),
+			Output:        redacted.OutputDB(),
+			Error:         redacted.ErrorDB(),
 			DotID:         result.Task.DotID(),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
+			Skipped:       result.Skipped,
+			Details:       redacted.DetailsDB(),
+			ExpiresAt:     expiresAt,
 			task:          result.Task,
 		})
 
@@ -579,11 +610,72 @@ func (r *runner) runReaper() {
 	ctx, cancel := utils.CombinedContext(context.Background(), r.chStop)
 	defer cancel()
 
-	err := r.orm.DeleteRunsOlderThan(ctx, r.config.JobPipelineReaperThreshold())
+	policy := RunRetentionPolicy{
+		MaxAge:       r.config.JobPipelineReaperThreshold(),
+		MaxFailedAge: r.config.JobPipelineReaperFailedThreshold(),
+		MaxRuns:      r.config.JobPipelineReaperMaxRuns(),
+	}
+	var archive *runArchive
+	var export func([]Run) error
+	if dir := r.config.JobPipelineReaperExportDir(); dir != "" {
+		archive = newRunArchive(dir)
+		export = archive.Write
+	}
+
+	deleted, err := r.orm.DeleteRuns(ctx, policy, export)
+	if archive != nil {
+		err = multierr.Append(err, errors.Wrap(archive.Close(), "failed to close run archive"))
+	}
 	if ctx.Err() != nil {
 		return
 	} else if err != nil {
-		r.lggr.Errorw("Pipeline run reaper failed", "error", err)
+		r.lggr.Errorw("Pipeline run reaper failed", "error", err, "deleted", deleted)
+		return
+	}
+	if deleted > 0 && archive != nil {
+		r.lggr.Infow("Pipeline run reaper archived and deleted runs", "deleted", deleted, "archive", archive.Path())
+	} else if deleted > 0 {
+		r.lggr.Debugw("Pipeline run reaper deleted runs", "deleted", deleted)
+	}
+}
+
+// asyncTaskExpiryInterval is how often suspended runs are checked for async
+// tasks that timed out.
+const asyncTaskExpiryInterval = 10 * time.Second
+
+func (r *runner) asyncTaskExpiryLoop() {
+	defer r.wgDone.Done()
+
+	ctx, cancel := utils.ContextFromChan(r.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(asyncTaskExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.chStop:
+			return
+		case <-ticker.C:
+			r.expireAsyncTasks(ctx)
+		}
+	}
+}
+
+// expireAsyncTasks fails the pending async tasks that were not resumed before
+// their timeout, resuming their runs.
+func (r *runner) expireAsyncTasks(ctx context.Context) {
+	taskRunIDs, err := r.orm.FindExpiredTaskRunIDs(ctx, time.Now())
+	if ctx.Err() != nil {
+		return
+	} else if err != nil {
+		r.lggr.Errorw("Failed to find expired async tasks", "err", err)
+		return
+	}
+	for _, taskRunID := range taskRunIDs {
+		r.lggr.Warnw("Async task timed out, failing it", "taskRunID", taskRunID)
+		if err = r.ResumeRun(taskRunID, nil, ErrAsyncTaskTimeout); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			r.lggr.Errorw("Failed to fail expired async task", "taskRunID", taskRunID, "err", err)
+		}
 	}
 }
 
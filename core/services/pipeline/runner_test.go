@@ -14,12 +14,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -39,7 +41,7 @@ func newRunner(t testing.TB, db *sqlx.DB, cfg *configtest.TestGeneralConfig) (pi
 
 	orm.On("GetQ").Return(q)
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t))
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, nil, nil, logger.TestLogger(t))
 	return r, orm
 }
 
@@ -438,7 +440,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr)
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, nil, nil, lggr)
 
 	spec := pipeline.Spec{DotDagSource: `
 fail_but_i_dont_care [type=fail]
@@ -746,6 +748,51 @@ ds5 [type=http method="GET" url="%s" index=2]
 	require.Len(t, errorResults, 3)
 }
 
+func Test_PipelineRunner_ExpireAsyncTasks(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := pipeline.NewORM(db, logger.TestLogger(t), cfg)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
+	r := pipeline.NewRunner(orm, cfg, cc, cltest.NewKeyStore(t, db, cfg).Eth(), nil, nil, nil, logger.TestLogger(t))
+
+	run := mustInsertAsyncRun(t, orm)
+	now := time.Now()
+	expiredID := uuid.NewV4()
+	run.PipelineTaskRuns = []pipeline.TaskRun{{
+		ID:            expiredID,
+		PipelineRunID: run.ID,
+		Type:          pipeline.TaskTypeBridge,
+		DotID:         "ds1",
+		CreatedAt:     now.Add(-time.Hour),
+		ExpiresAt:     null.TimeFrom(now.Add(-time.Minute)),
+	}}
+	_, err := orm.StoreRun(run)
+	require.NoError(t, err)
+	require.Equal(t, pipeline.RunStatusSuspended, run.State)
+
+	ids, err := orm.FindExpiredTaskRunIDs(testutils.Context(t), time.Now())
+	require.NoError(t, err)
+	require.Equal(t, []uuid.UUID{expiredID}, ids)
+
+	r.ExpireAsyncTasks(testutils.Context(t))
+
+	// The run is resumed in the background with the task failed
+	var finished pipeline.Run
+	require.Eventually(t, func() bool {
+		finished, err = orm.FindRun(run.ID)
+		require.NoError(t, err)
+		return finished.FinishedAt.Valid
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(t, pipeline.RunStatusErrored, finished.State)
+	ds1 := finished.ByDotID("ds1")
+	require.NotNil(t, ds1)
+	assert.Equal(t, pipeline.ErrAsyncTaskTimeout.Error(), ds1.Error.String)
+
+	ids, err = orm.FindExpiredTaskRunIDs(testutils.Context(t), time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
 func Test_PipelineRunner_LowercaseOutputs(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
@@ -790,3 +837,39 @@ a [type=uppercase input="$(first)"]
 	require.NoError(t, err)
 	assert.Equal(t, "SOMERANDOMTEST", result.Value.(string))
 }
+
+func Test_PipelineRunner_Secrets(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
+	orm := new(mocks.ORM)
+	orm.On("GetQ").Return(pg.NewQ(db, logger.TestLogger(t), cfg))
+	keyStore := cltest.NewKeyStore(t, db, cfg)
+	_, err := keyStore.Secrets().Create("apiKey", "s3cr3t")
+	require.NoError(t, err)
+	r := pipeline.NewRunner(orm, cfg, cc, keyStore.Eth(), nil, keyStore.Secrets(), nil, logger.TestLogger(t))
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "s3cr3t", req.Header.Get("X-Api-Key"))
+		_, err := w.Write([]byte(`{"echo": "s3cr3t"}`))
+		require.NoError(t, err)
+	}))
+	defer s.Close()
+
+	lggr := logger.TestLogger(t)
+	run, trrs, err := r.ExecuteRun(context.Background(), pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+ds [type=http method=GET url="%s" headers=<{"X-Api-Key": $(secrets.apiKey)}> allowUnrestrictedNetworkAccess=true]
+`, s.URL),
+	}, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+
+	result, err := trrs.FinalResult(lggr).SingularResult()
+	require.NoError(t, err)
+	assert.Equal(t, `{"echo": "s3cr3t"}`, result.Value)
+
+	require.Len(t, run.PipelineTaskRuns, 1)
+	assert.Equal(t, `{"echo": "[redacted]"}`, run.PipelineTaskRuns[0].Output.Val)
+	assert.Equal(t, []interface{}{`{"echo": "[redacted]"}`}, run.Outputs.Val)
+	assert.NotContains(t, run.Inputs.Val, pipeline.SecretsVarsKey)
+}
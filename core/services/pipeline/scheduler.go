@@ -54,6 +54,7 @@ type scheduler struct {
 	pipeline     *Pipeline
 	run          *Run
 	dependencies map[int]uint
+	notTaken     map[int]uint // dependencies that were skipped or whose conditional edge was not taken
 	waiting      uint
 	results      map[int]TaskRunResult
 	vars         Vars
@@ -83,6 +84,7 @@ func newScheduler(p *Pipeline, run *Run, vars Vars, lggr logger.Logger) *schedul
 		pipeline:     p,
 		run:          run,
 		dependencies: dependencies,
+		notTaken:     make(map[int]uint, len(p.Tasks)),
 		results:      make(map[int]TaskRunResult, len(p.Tasks)),
 		vars:         vars,
 		logger:       lggr,
@@ -141,15 +143,19 @@ func (s *scheduler) reconstructResults() {
 			result.Value = r.Output.Val
 		}
 
-		s.results[task.ID()] = TaskRunResult{
+		trr := TaskRunResult{
 			Task:       task,
 			Result:     result,
 			CreatedAt:  r.CreatedAt,
 			FinishedAt: r.FinishedAt,
+			Skipped:    r.Skipped,
 		}
+		s.results[task.ID()] = trr
 
 		// store the result in vars
-		if result.Error != nil {
+		if trr.Skipped {
+			// skipped tasks have no result
+		} else if result.Error != nil {
 			s.vars.Set(task.DotID(), result.Error)
 		} else {
 			s.vars.Set(task.DotID(), result.Value)
@@ -159,6 +165,9 @@ func (s *scheduler) reconstructResults() {
 		for _, output := range task.Outputs() {
 			id := output.ID()
 			s.dependencies[id]--
+			if !isDependencyTaken(trr, output) {
+				s.notTaken[id]++
+			}
 		}
 	}
 }
@@ -252,24 +261,67 @@ func (s *scheduler) Run() {
 			continue
 		}
 
-		for _, output := range result.Task.Outputs() {
-			id := output.ID()
-			s.dependencies[id]--
+		s.scheduleOutputs(result)
+	}
+
+	close(s.taskCh)
+}
 
-			// if all dependencies are done, schedule task run
-			if s.dependencies[id] == 0 {
-				task := s.pipeline.Tasks[id]
-				run := s.newMemoryTaskRun(task)
+// scheduleOutputs marks the result as a completed dependency of each of the
+// task's outputs, and schedules the outputs whose dependencies are all done.
+// An output is skipped instead if any of its dependencies was skipped or
+// reached it through a conditional edge that was not taken.
+func (s *scheduler) scheduleOutputs(result TaskRunResult) {
+	for _, output := range result.Task.Outputs() {
+		id := output.ID()
+		s.dependencies[id]--
+		if !isDependencyTaken(result, output) {
+			s.notTaken[id]++
+		}
 
-				s.logger.Debugw("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
-				s.taskCh <- run
-				s.waiting++
+		// if all dependencies are done, schedule task run
+		if s.dependencies[id] == 0 {
+			task := s.pipeline.Tasks[id]
+
+			if s.notTaken[id] > 0 {
+				s.logger.Debugw("skipping task run", "dot_id", task.DotID())
+				skipped := s.skip(task)
+				s.scheduleOutputs(skipped)
+				continue
 			}
+
+			run := s.newMemoryTaskRun(task)
+
+			s.logger.Debugw("scheduling task run", "dot_id", run.task.DotID(), "attempts", run.attempts)
+			s.taskCh <- run
+			s.waiting++
 		}
+	}
+}
 
+// skip records a task as skipped without running it
+func (s *scheduler) skip(task Task) TaskRunResult {
+	now := time.Now()
+	result := TaskRunResult{
+		ID:         task.Base().uuid,
+		Task:       task,
+		CreatedAt:  now,
+		FinishedAt: null.TimeFrom(now),
+		Skipped:    true,
 	}
+	s.results[task.ID()] = result
+	return result
+}
 
-	close(s.taskCh)
+// isDependencyTaken returns true if the result of the input task allows the
+// given output task to run
+func isDependencyTaken(result TaskRunResult, output Task) bool {
+	for _, dep := range output.Inputs() {
+		if dep.InputTask.ID() == result.Task.ID() {
+			return dep.IsTaken(result)
+		}
+	}
+	return !result.Skipped
 }
 
 func (s *scheduler) markRemaining(err error) {
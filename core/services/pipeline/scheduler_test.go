@@ -127,6 +127,85 @@ func Test_Scheduler(t *testing.T) {
 				require.Equal(t, ErrCancelled, result.Result.Error)
 			},
 		},
+		{
+			name: "conditional: only the branch matching the result is taken",
+			spec: `
+			a [type=conditional]
+			b [type=median index=0]
+			c [type=median index=1]
+			d [type=median index=2]
+			a -> b [when=true]
+			a -> c [when=false]
+			c -> d`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Value: true},
+				},
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+				// c and d are skipped
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.False(t, results[p.ByDotID("b").ID()].Skipped)
+				require.Equal(t, 1, results[p.ByDotID("b").ID()].Result.Value)
+
+				for _, dotID := range []string{"c", "d"} {
+					result := results[p.ByDotID(dotID).ID()]
+					require.True(t, result.Skipped)
+					require.Equal(t, uint(0), result.Attempts)
+					require.Equal(t, Result{}, result.Result)
+					require.True(t, result.FinishedAt.Valid)
+				}
+			},
+		},
+		{
+			name: "conditional: errored conditional takes no branch",
+			spec: `
+			a [type=conditional]
+			b [type=median index=0]
+			c [type=median index=1]
+			a -> b [when=true]
+			a -> c [when=false]`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrBadInput},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.True(t, results[p.ByDotID("b").ID()].Skipped)
+				require.True(t, results[p.ByDotID("c").ID()].Skipped)
+			},
+		},
+		{
+			name: "conditional: a task is skipped if any of its inputs is skipped",
+			spec: `
+			a [type=conditional]
+			b [type=median]
+			c [type=median]
+			d [type=median index=0]
+			a -> b [when=true]
+			b -> d
+			c -> d`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Value: false},
+				},
+				{
+					expected: "c",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				require.True(t, results[p.ByDotID("b").ID()].Skipped)
+				require.False(t, results[p.ByDotID("c").ID()].Skipped)
+				require.True(t, results[p.ByDotID("d").ID()].Skipped)
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,233 @@
+package pipeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// RedactedSecret replaces secret values in persisted task run outputs, errors and logs
+const RedactedSecret = "[redacted]"
+
+//go:generate mockery --name SecretsKeyStore --output ./mocks/ --case=underscore
+
+type SecretsKeyStore interface {
+	GetAllValues() (map[string]string, error)
+}
+
+// specReferencesSecrets reports whether any $(...) expression in the DAG
+// source reads from the secrets vars.
+func specReferencesSecrets(dotDagSource string) bool {
+	for _, match := range variableRegexp.FindAllStringSubmatch(dotDagSource, -1) {
+		if strings.SplitN(match[1], ".", 2)[0] == SecretsVarsKey {
+			return true
+		}
+	}
+	return false
+}
+
+// withSecrets returns a copy of vars with the node secrets set under
+// SecretsVarsKey, along with a redactor for their values. Secrets are only
+// loaded if the spec references them, and are never added to the run's
+// persisted inputs.
+func (r *runner) withSecrets(spec Spec, vars Vars) (Vars, *secretRedactor, error) {
+	if r.secretsKeyStore == nil || !specReferencesSecrets(spec.DotDagSource) {
+		return vars, nil, nil
+	}
+	values, err := r.secretsKeyStore.GetAllValues()
+	if err != nil {
+		return vars, nil, errors.Wrap(err, "unable to load secrets")
+	}
+	secrets := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		secrets[name] = value
+	}
+	vars = vars.Copy()
+	vars.vars[SecretsVarsKey] = secrets
+	return vars, newSecretRedactor(values), nil
+}
+
+// secretRedactor replaces every occurrence of a secret value with
+// RedactedSecret. A nil *secretRedactor is valid and redacts nothing.
+type secretRedactor struct {
+	replacer *strings.Replacer
+}
+
+func newSecretRedactor(secrets map[string]string) *secretRedactor {
+	values := make([]string, 0, len(secrets))
+	for _, value := range secrets {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	// longest first, so that a secret containing another secret is redacted as a whole
+	sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+	oldnew := make([]string, 0, 2*len(values))
+	for _, value := range values {
+		oldnew = append(oldnew, value, RedactedSecret)
+	}
+	return &secretRedactor{strings.NewReplacer(oldnew...)}
+}
+
+func (r *secretRedactor) String(s string) string {
+	if r == nil {
+		return s
+	}
+	return r.replacer.Replace(s)
+}
+
+// Result returns a copy of res with secrets redacted from its value and error
+func (r *secretRedactor) Result(res Result) Result {
+	if r == nil {
+		return res
+	}
+	redacted := Result{Value: r.Value(res.Value)}
+	if res.Details != nil {
+		redacted.Details, _ = r.Value(res.Details).(map[string]interface{})
+	}
+	if res.Error != nil {
+		redacted.Error = errors.New(r.String(res.Error.Error()))
+	}
+	return redacted
+}
+
+// Value redacts strings nested anywhere in v, which is expected to be a task
+// result value. Values of other types are returned unchanged.
+func (r *secretRedactor) Value(v interface{}) interface{} {
+	if r == nil {
+		return v
+	}
+	switch val := v.(type) {
+	case string:
+		return r.String(val)
+	case []byte:
+		return []byte(r.String(string(val)))
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(val))
+		for k, elem := range val {
+			redacted[r.String(k)] = r.Value(elem)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(val))
+		for i, elem := range val {
+			redacted[i] = r.Value(elem)
+		}
+		return redacted
+	default:
+		return v
+	}
+}
+
+// Logger wraps l so that secrets are redacted from everything it logs
+func (r *secretRedactor) Logger(l logger.Logger) logger.Logger {
+	if r == nil {
+		return l
+	}
+	return &redactingLogger{Logger: l, redactor: r}
+}
+
+// logArg redacts a single log argument, formatting non-string values only
+// when their string form contains a secret.
+func (r *secretRedactor) logArg(arg interface{}) interface{} {
+	switch v := arg.(type) {
+	case string:
+		return r.String(v)
+	case []byte:
+		return r.String(string(v))
+	case nil:
+		return nil
+	}
+	s := fmt.Sprintf("%v", arg)
+	if redacted := r.String(s); redacted != s {
+		return redacted
+	}
+	return arg
+}
+
+func (r *secretRedactor) logArgs(args []interface{}) []interface{} {
+	redacted := make([]interface{}, len(args))
+	for i, arg := range args {
+		redacted[i] = r.logArg(arg)
+	}
+	return redacted
+}
+
+// redactingLogger is a logger.Logger that redacts secrets from messages and
+// fields before passing them on to the wrapped logger.
+type redactingLogger struct {
+	logger.Logger
+	redactor *secretRedactor
+}
+
+func (l *redactingLogger) With(args ...interface{}) logger.Logger {
+	return &redactingLogger{l.Logger.With(l.redactor.logArgs(args)...), l.redactor}
+}
+
+func (l *redactingLogger) Named(name string) logger.Logger {
+	return &redactingLogger{l.Logger.Named(name), l.redactor}
+}
+
+func (l *redactingLogger) Helper(skip int) logger.Logger {
+	return &redactingLogger{l.Logger.Helper(skip), l.redactor}
+}
+
+func (l *redactingLogger) Trace(args ...interface{}) { l.Logger.Trace(l.redactor.logArgs(args)...) }
+func (l *redactingLogger) Debug(args ...interface{}) { l.Logger.Debug(l.redactor.logArgs(args)...) }
+func (l *redactingLogger) Info(args ...interface{})  { l.Logger.Info(l.redactor.logArgs(args)...) }
+func (l *redactingLogger) Warn(args ...interface{})  { l.Logger.Warn(l.redactor.logArgs(args)...) }
+func (l *redactingLogger) Error(args ...interface{}) { l.Logger.Error(l.redactor.logArgs(args)...) }
+func (l *redactingLogger) Critical(args ...interface{}) {
+	l.Logger.Critical(l.redactor.logArgs(args)...)
+}
+
+func (l *redactingLogger) Tracef(format string, values ...interface{}) {
+	l.Logger.Trace(l.redactor.String(fmt.Sprintf(format, values...)))
+}
+func (l *redactingLogger) Debugf(format string, values ...interface{}) {
+	l.Logger.Debug(l.redactor.String(fmt.Sprintf(format, values...)))
+}
+func (l *redactingLogger) Infof(format string, values ...interface{}) {
+	l.Logger.Info(l.redactor.String(fmt.Sprintf(format, values...)))
+}
+func (l *redactingLogger) Warnf(format string, values ...interface{}) {
+	l.Logger.Warn(l.redactor.String(fmt.Sprintf(format, values...)))
+}
+func (l *redactingLogger) Errorf(format string, values ...interface{}) {
+	l.Logger.Error(l.redactor.String(fmt.Sprintf(format, values...)))
+}
+func (l *redactingLogger) Criticalf(format string, values ...interface{}) {
+	l.Logger.Critical(l.redactor.String(fmt.Sprintf(format, values...)))
+}
+
+func (l *redactingLogger) Tracew(msg string, keysAndValues ...interface{}) {
+	l.Logger.Tracew(l.redactor.String(msg), l.redactor.logArgs(keysAndValues)...)
+}
+func (l *redactingLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	l.Logger.Debugw(l.redactor.String(msg), l.redactor.logArgs(keysAndValues)...)
+}
+func (l *redactingLogger) Infow(msg string, keysAndValues ...interface{}) {
+	l.Logger.Infow(l.redactor.String(msg), l.redactor.logArgs(keysAndValues)...)
+}
+func (l *redactingLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	l.Logger.Warnw(l.redactor.String(msg), l.redactor.logArgs(keysAndValues)...)
+}
+func (l *redactingLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	l.Logger.Errorw(l.redactor.String(msg), l.redactor.logArgs(keysAndValues)...)
+}
+func (l *redactingLogger) Criticalw(msg string, keysAndValues ...interface{}) {
+	l.Logger.Criticalw(l.redactor.String(msg), l.redactor.logArgs(keysAndValues)...)
+}
+
+func (l *redactingLogger) ErrorIf(err error, msg string) {
+	if err != nil {
+		l.Logger.Errorw(l.redactor.String(msg), "err", l.redactor.logArg(err))
+	}
+}
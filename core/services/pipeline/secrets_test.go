@@ -0,0 +1,95 @@
+package pipeline
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestSpecReferencesSecrets(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, specReferencesSecrets(`ds [type=http url="https://example.com?key=$(secrets.apiKey)"]`))
+	assert.True(t, specReferencesSecrets(`ds [type=http headers="{\"X-Key\": $( secrets.apiKey )}"]`))
+	assert.False(t, specReferencesSecrets(`ds [type=http url="$(jobRun.requestBody)"]`))
+	assert.False(t, specReferencesSecrets(`ds [type=http url="$(mysecrets.apiKey)"]`))
+}
+
+func TestSecretRedactor(t *testing.T) {
+	t.Parallel()
+
+	r := newSecretRedactor(map[string]string{"short": "abc", "long": "abcdef", "empty": ""})
+	require.NotNil(t, r)
+
+	assert.Equal(t, "key=[redacted]&other=[redacted]", r.String("key=abcdef&other=abc"))
+	assert.Equal(t, "nothing to see", r.String("nothing to see"))
+
+	value := r.Value(map[string]interface{}{
+		"list":   []interface{}{"xabcx", int64(1)},
+		"bytes":  []byte("abc"),
+		"nested": map[string]interface{}{"abc": "abcdef"},
+	})
+	assert.Equal(t, map[string]interface{}{
+		"list":   []interface{}{"x[redacted]x", int64(1)},
+		"bytes":  []byte("[redacted]"),
+		"nested": map[string]interface{}{"[redacted]": "[redacted]"},
+	}, value)
+
+	result := r.Result(Result{Value: "abc", Error: errors.New("bad key abcdef")})
+	assert.Equal(t, "[redacted]", result.Value)
+	assert.EqualError(t, result.Error, "bad key [redacted]")
+
+	t.Run("nil redactor", func(t *testing.T) {
+		var r *secretRedactor
+		assert.Nil(t, newSecretRedactor(map[string]string{"empty": ""}))
+		assert.Equal(t, "abc", r.String("abc"))
+		assert.Equal(t, Result{Value: "abc"}, r.Result(Result{Value: "abc"}))
+		assert.Equal(t, logger.NullLogger, r.Logger(logger.NullLogger))
+	})
+}
+
+type capturingLogger struct {
+	logger.Logger
+	logs *[]string
+}
+
+func (l capturingLogger) With(args ...interface{}) logger.Logger {
+	*l.logs = append(*l.logs, fmt.Sprint(args...))
+	return l
+}
+
+func (l capturingLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	*l.logs = append(*l.logs, msg+fmt.Sprint(keysAndValues...))
+}
+
+func (l capturingLogger) Debug(args ...interface{}) {
+	*l.logs = append(*l.logs, fmt.Sprint(args...))
+}
+
+func (l capturingLogger) Error(args ...interface{}) {
+	*l.logs = append(*l.logs, fmt.Sprint(args...))
+}
+
+func TestSecretRedactor_Logger(t *testing.T) {
+	t.Parallel()
+
+	var logs []string
+	r := newSecretRedactor(map[string]string{"apiKey": "s3cr3t"})
+	lggr := r.Logger(capturingLogger{logger.NullLogger, &logs})
+
+	lggr = lggr.With("url", "https://example.com?key=s3cr3t")
+	lggr.Debugw("sending s3cr3t", "requestData", map[string]interface{}{"key": "s3cr3t"}, "err", errors.New("s3cr3t rejected"))
+	lggr.Debugf("key %s", "s3cr3t")
+	lggr.Error("s3cr3t")
+
+	require.Len(t, logs, 4)
+	for _, log := range logs {
+		assert.NotContains(t, log, "s3cr3t")
+		assert.Contains(t, log, RedactedSecret)
+	}
+}
@@ -3,9 +3,12 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"path"
+	"time"
 
+	"github.com/jpillora/backoff"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
@@ -25,9 +28,11 @@ type BridgeTask struct {
 	RequestData       string `json:"requestData"`
 	IncludeInputAtKey string `json:"includeInputAtKey"`
 	Async             string `json:"async"`
+	AsyncTimeout      string `json:"asyncTimeout"`
 
-	queryer pg.Queryer
-	config  Config
+	queryer     pg.Queryer
+	config      Config
+	csaKeyStore CSAKeyStore
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -48,20 +53,23 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		name              StringParam
 		requestData       MapParam
 		includeInputAtKey StringParam
+		asyncTimeout      MaybeDurationParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
+		errors.Wrap(ResolveParam(&asyncTimeout, From(VarExpr(t.AsyncTimeout, vars), t.AsyncTimeout)), "asyncTimeout"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bridge, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bridge.URL)
 
 	var metaMap MapParam
 
@@ -105,25 +113,60 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 	)
 
-	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
-	defer cancel()
+	// Async bridges are never cached, since their responses may only signal that the run is pending
+	cacheTTL, staleIfError := bridge.CacheTTL.Duration(), bridge.StaleIfError.Duration()
+	cacheEnabled := t.Async != "true" && (cacheTTL > 0 || staleIfError > 0)
+	var cacheKey string
+	if cacheEnabled {
+		cacheKey, err = bridgeCacheKey(name, requestData)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		if cacheTTL > 0 {
+			if responseBytes, _, exists := bridgeCache.Get(cacheKey, cacheTTL); exists {
+				promBridgeCacheHits.WithLabelValues(string(name)).Inc()
+				lggr.Debugw("Bridge task: using cached response", "url", url.String(), "dotID", t.DotID())
+				return Result{Value: string(responseBytes)}, runInfo
+			}
+			promBridgeCacheMisses.WithLabelValues(string(name)).Inc()
+		}
+	}
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, headers, elapsed, err := t.sendRequest(ctx, lggr, bridge, url, requestData, allowUnrestrictedNetworkAccess)
 	if err != nil {
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		if cacheEnabled && staleIfError > 0 {
+			if responseBytes, age, exists := bridgeCache.Get(cacheKey, cacheTTL+staleIfError); exists {
+				promBridgeStaleResponses.WithLabelValues(string(name)).Inc()
+				promBridgeStaleResponseAge.WithLabelValues(string(name)).Set(age.Seconds())
+				lggr.Warnw("Bridge task: request failed, falling back to last good response",
+					"err", err,
+					"age", age,
+					"url", url.String(),
+					"dotID", t.DotID(),
+				)
+				return Result{Value: string(responseBytes)}, runInfo
+			}
+		}
+		return Result{Error: err}, RunInfo{IsRetryable: isRetryableBridgeError(statusCode, err)}
+	}
+
+	if cacheEnabled {
+		bridgeCache.Set(cacheKey, responseBytes, cacheTTL+staleIfError)
 	}
 
 	if t.Async == "true" {
 		// Look for a `pending` flag. This check is case-insensitive because http.Header normalizes header names
+		pendingInfo := pendingRunInfo()
+		pendingInfo.PendingTimeout, _ = asyncTimeout.Duration()
 		if _, ok := headers["X-Chainlink-Pending"]; ok {
-			return result, pendingRunInfo()
+			return result, pendingInfo
 		}
 
 		var response struct {
 			Pending bool `json:"pending"`
 		}
 		if err := json.Unmarshal(responseBytes, &response); err == nil && response.Pending {
-			return Result{}, pendingRunInfo()
+			return Result{}, pendingInfo
 		}
 	}
 
@@ -144,13 +187,119 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
-	var bt bridges.BridgeType
-	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+// sendRequest calls the bridge, applying its timeout, retry and circuit
+// breaker policy.
+func (t *BridgeTask) sendRequest(ctx context.Context, lggr logger.Logger, bridge bridges.BridgeType, url URLParam, requestData MapParam, allowUnrestrictedNetworkAccess BoolParam) (responseBytes []byte, statusCode int, headers http.Header, elapsed time.Duration, err error) {
+	name := bridge.Name.String()
+	threshold := bridge.CircuitBreakerThreshold
+	cooldown := bridge.CircuitBreakerCooldown.Duration()
+	if cooldown == 0 {
+		cooldown = defaultBridgeCircuitBreakerCooldown
+	}
+	retryBackoff := backoff.Backoff{
+		Factor: 2,
+		Min:    bridgeRetryMinBackoff,
+		Max:    bridgeRetryMaxBackoff,
+	}
+
+	for attempt := uint32(0); ; attempt++ {
+		// Each attempt is signed with a fresh timestamp
+		var requestHeaders map[string]string
+		if requestHeaders, err = t.signRequest(bridge, requestData); err != nil {
+			return nil, 0, nil, 0, err
+		}
+
+		if threshold > 0 {
+			if err = bridgeBreakers.Allow(name, cooldown); err != nil {
+				promBridgeCircuitBreakerRejections.WithLabelValues(name).Inc()
+				return nil, 0, nil, 0, errors.Wrapf(err, "bridge %s", name)
+			}
+		}
+
+		responseBytes, statusCode, headers, elapsed, err = t.makeRequest(ctx, lggr, bridge, url, requestData, requestHeaders, allowUnrestrictedNetworkAccess)
+		retryable := err != nil && isRetryableBridgeError(statusCode, err)
+		if threshold > 0 {
+			outcome := bridgeOutcomeSuccess
+			if ctx.Err() != nil {
+				outcome = bridgeOutcomeUnknown
+			} else if retryable {
+				outcome = bridgeOutcomeFailure
+			}
+			bridgeBreakers.Record(name, threshold, outcome)
+		}
+
+		if !retryable || attempt >= bridge.Retries || ctx.Err() != nil {
+			return
+		}
+		promBridgeRequestRetries.WithLabelValues(name).Inc()
+		lggr.Debugw("Bridge task: request failed, retrying",
+			"err", err,
+			"attempt", attempt+1,
+			"url", url.String(),
+			"dotID", t.DotID(),
+		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryBackoff.ForAttempt(float64(attempt))):
+		}
+	}
+}
+
+// signRequest returns the headers signing the request, if the bridge is
+// configured to sign requests.
+func (t *BridgeTask) signRequest(bridge bridges.BridgeType, requestData MapParam) (map[string]string, error) {
+	if bridge.RequestSigning == bridges.RequestSigningNone {
+		return nil, nil
+	}
+	// makeHTTPRequest encodes the body in the same way
+	body, err := json.Marshal(requestData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode request body as JSON")
+	}
+	return signBridgeRequest(bridge, t.csaKeyStore, body, time.Now())
+}
+
+// isRetryableBridgeError is like isRetryableHTTPError, except that responses
+// with an invalid signature are never retried.
+func isRetryableBridgeError(statusCode int, err error) bool {
+	return !errors.Is(err, ErrBridgeResponseSignature) && isRetryableHTTPError(statusCode, err)
+}
+
+// makeRequest makes a single request to the bridge, and records it in the
+// bridge's request stats. The bridge timeout, if set, takes precedence over
+// the default HTTP timeout. Responses are verified if the bridge sets a
+// response public key.
+func (t *BridgeTask) makeRequest(ctx context.Context, lggr logger.Logger, bridge bridges.BridgeType, url URLParam, requestData MapParam, requestHeaders map[string]string, allowUnrestrictedNetworkAccess BoolParam) ([]byte, int, http.Header, time.Duration, error) {
+	var requestCtx context.Context
+	var cancel context.CancelFunc
+	if timeout := bridge.Timeout.Duration(); timeout > 0 {
+		requestCtx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		requestCtx, cancel = httpRequestCtx(ctx, t, t.config)
+	}
+	defer cancel()
+
+	start := time.Now()
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, requestData, requestHeaders, allowUnrestrictedNetworkAccess, t.config.DefaultHTTPLimit())
+	if err == nil && bridge.ResponsePublicKey != "" {
+		if err = verifyBridgeResponse(bridge.ResponsePublicKey, headers, responseBytes, time.Now()); err != nil {
+			responseBytes = nil
+		}
+	}
+	// Requests aborted by the caller say nothing about the bridge
+	if ctx.Err() == nil {
+		bridgeStats.Record(bridge.Name.String(), time.Since(start), err == nil)
+	}
+	return responseBytes, statusCode, headers, elapsed, err
+}
+
+func (t BridgeTask) getBridgeFromName(name StringParam) (bt bridges.BridgeType, err error) {
+	err = t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -2,6 +2,10 @@ package pipeline_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -9,7 +13,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -191,9 +197,16 @@ func TestBridgeTask_AsyncJobPendingState(t *testing.T) {
 	result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
 	assert.True(t, runInfo.IsPending)
 	assert.False(t, runInfo.IsRetryable)
+	assert.Zero(t, runInfo.PendingTimeout)
 
 	require.NoError(t, result.Error)
 	require.Nil(t, result.Value)
+
+	task.AsyncTimeout = "$(timeout)"
+	result, runInfo = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(map[string]interface{}{"timeout": "5m"}), nil)
+	assert.True(t, runInfo.IsPending)
+	assert.Equal(t, 5*time.Minute, runInfo.PendingTimeout)
+	require.NoError(t, result.Error)
 }
 
 func TestBridgeTask_Variables(t *testing.T) {
@@ -609,3 +622,226 @@ func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
 		})
 	}
 }
+
+func TestBridgeTask_CacheTTLAndStaleIfError(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	var failing atomic.Bool
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := requests.Inc()
+		w.Header().Set("Content-Type", "application/json")
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(fmt.Sprintf(`{"n": %d}`, n)))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	feedURL, err := url.ParseRequestURI(server.URL)
+	require.NoError(t, err)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: feedURL.String()}, cfg)
+	setBridgeCache := func(cacheTTL, staleIfError time.Duration) {
+		_, err := db.Exec(`UPDATE bridge_types SET cache_ttl = $1, stale_if_error = $2 WHERE name = $3`, cacheTTL, staleIfError, bridge.Name.String())
+		require.NoError(t, err)
+	}
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg, db, uuid.UUID{})
+
+	run := func() pipeline.Result {
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("caches responses for cacheTTL", func(t *testing.T) {
+		setBridgeCache(time.Hour, 0)
+
+		result := run()
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"n": 1}`, result.Value)
+
+		result = run()
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"n": 1}`, result.Value)
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("falls back to the last good response if the request fails", func(t *testing.T) {
+		setBridgeCache(0, time.Hour)
+
+		result := run()
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"n": 2}`, result.Value)
+
+		failing.Store(true)
+		result = run()
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"n": 2}`, result.Value)
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("errors if the request fails without stale-if-error", func(t *testing.T) {
+		setBridgeCache(0, 0)
+
+		result := run()
+		require.Error(t, result.Error)
+		require.Nil(t, result.Value)
+	})
+}
+
+func TestBridgeTask_RetriesAndCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var requests atomic.Int32
+	var failures atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		w.Header().Set("Content-Type", "application/json")
+		if failures.Load() > 0 {
+			failures.Dec()
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(`{"ok": true}`))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	feedURL, err := url.ParseRequestURI(server.URL)
+	require.NoError(t, err)
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: feedURL.String()}, cfg)
+	setBridgePolicy := func(retries, threshold uint32, cooldown time.Duration) {
+		_, err := db.Exec(`UPDATE bridge_types SET retries = $1, circuit_breaker_threshold = $2, circuit_breaker_cooldown = $3 WHERE name = $4`,
+			retries, threshold, cooldown, bridge.Name.String())
+		require.NoError(t, err)
+	}
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg, db, uuid.UUID{})
+
+	run := func() pipeline.Result {
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("retries failed requests", func(t *testing.T) {
+		setBridgePolicy(2, 0, 0)
+		failures.Store(2)
+		requests.Store(0)
+
+		result := run()
+		require.NoError(t, result.Error)
+		require.Equal(t, `{"ok": true}`, result.Value)
+		require.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("opens the circuit after consecutive failures and closes it after a successful probe", func(t *testing.T) {
+		setBridgePolicy(0, 2, 100*time.Millisecond)
+		failures.Store(2)
+		requests.Store(0)
+
+		require.Error(t, run().Error)
+		require.Error(t, run().Error)
+		assert.Equal(t, pipeline.BridgeCircuitOpen, pipeline.GetBridgeCircuitBreakerStatus(bridge.Name.String()).State)
+
+		// Fails fast without calling the bridge
+		result := run()
+		require.True(t, errors.Is(result.Error, pipeline.ErrBridgeCircuitOpen))
+		require.Equal(t, int32(2), requests.Load())
+
+		time.Sleep(100 * time.Millisecond)
+		result = run()
+		require.NoError(t, result.Error)
+		require.Equal(t, int32(3), requests.Load())
+		status := pipeline.GetBridgeCircuitBreakerStatus(bridge.Name.String())
+		assert.Equal(t, pipeline.BridgeCircuitClosed, status.State)
+		assert.Equal(t, uint32(0), status.ConsecutiveFailures)
+	})
+}
+
+func TestBridgeTask_SignedRequestsAndResponses(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	responsePub, responsePriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	var signResponse atomic.Bool
+	var outgoingToken atomic.String
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		// Check the HMAC signature of the request
+		timestamp := r.Header.Get(pipeline.BridgeTimestampHeader)
+		mac := hmac.New(sha256.New, []byte(outgoingToken.Load()))
+		mac.Write(pipeline.BridgeSigningPayload(timestamp, body))
+		if r.Header.Get(pipeline.BridgeSignatureHeader) != hex.EncodeToString(mac.Sum(nil)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		response := []byte(`{"data":{"result":"123"}}`)
+		if signResponse.Load() {
+			responseTimestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			w.Header().Set(pipeline.BridgeTimestampHeader, responseTimestamp)
+			w.Header().Set(pipeline.BridgeSignatureHeader, hex.EncodeToString(ed25519.Sign(responsePriv, pipeline.BridgeSigningPayload(responseTimestamp, response))))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err = w.Write(response)
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: server.URL}, cfg)
+	outgoingToken.Store(bridge.OutgoingToken)
+	_, err = db.Exec(`UPDATE bridge_types SET request_signing = 'hmac', response_public_key = $1 WHERE name = $2`,
+		hex.EncodeToString(responsePub), bridge.Name.String())
+	require.NoError(t, err)
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bridge.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg, db, uuid.UUID{})
+
+	t.Run("accepts signed responses", func(t *testing.T) {
+		signResponse.Store(true)
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"data":{"result":"123"}}`, result.Value)
+	})
+
+	t.Run("rejects unsigned responses", func(t *testing.T) {
+		signResponse.Store(false)
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.True(t, errors.Is(result.Error, pipeline.ErrBridgeResponseSignature), "unexpected error %v", result.Error)
+		assert.False(t, runInfo.IsRetryable)
+	})
+}
@@ -0,0 +1,47 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ConditionalTask resolves its input to a boolean. Edges out of a conditional
+// task can be annotated with a `when` attribute, in which case they are only
+// taken if the result matches; tasks reached only through edges that are not
+// taken are skipped, and so are all of their outputs. For example:
+//
+//    check  [type=expr expr="$(answer) > 100"];
+//    cond   [type=conditional];
+//    submit [type=ethtx ...];
+//    check -> cond -> submit [when=true];
+//
+// Return types:
+//    bool
+//
+type ConditionalTask struct {
+	BaseTask `mapstructure:",squash"`
+	Data     string `json:"data"`
+}
+
+var _ Task = (*ConditionalTask)(nil)
+
+func (t *ConditionalTask) Type() TaskType {
+	return TaskTypeConditional
+}
+
+func (t *ConditionalTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var data BoolParam
+	err = errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data), Input(inputs, 0))), "data")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: bool(data)}, runInfo
+}
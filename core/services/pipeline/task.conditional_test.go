@@ -0,0 +1,57 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestConditionalTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		data           string
+		inputs         []pipeline.Result
+		vars           pipeline.Vars
+		want           bool
+		wantErrorCause error
+	}{
+		{"literal true", "true", nil, pipeline.NewVarsFrom(nil), true, nil},
+		{"literal false", "false", nil, pipeline.NewVarsFrom(nil), false, nil},
+		{"input bool", "", []pipeline.Result{{Value: true}}, pipeline.NewVarsFrom(nil), true, nil},
+		{"input string", "", []pipeline.Result{{Value: "false"}}, pipeline.NewVarsFrom(nil), false, nil},
+		{"var", "$(foo.bar)", nil, pipeline.NewVarsFrom(map[string]interface{}{"foo": map[string]interface{}{"bar": true}}), true, nil},
+		{"not a bool", "", []pipeline.Result{{Value: 42}}, pipeline.NewVarsFrom(nil), false, pipeline.ErrBadInput},
+		{"missing var", "$(foo)", nil, pipeline.NewVarsFrom(nil), false, pipeline.ErrKeypathNotFound},
+		{"errored input", "", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.NewVarsFrom(nil), false, pipeline.ErrTooManyErrors},
+		{"no data", "", nil, pipeline.NewVarsFrom(nil), false, pipeline.ErrParameterEmpty},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ConditionalTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Data:     test.data,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				return
+			}
+			require.NoError(t, result.Error)
+			require.Equal(t, test.want, result.Value)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package pipeline
 import (
 	"context"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
@@ -52,20 +51,9 @@ func (t *ETHABIDecodeLogTask) Run(_ context.Context, _ logger.Logger, vars Vars,
 		return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
 	}
 
-	out := make(map[string]interface{})
-	if len(data) > 0 {
-		if err2 := args.UnpackIntoMap(out, []byte(data)); err2 != nil {
-			return Result{Error: errors.Wrap(ErrBadInput, err2.Error())}, runInfo
-		}
-	}
-	if len(indexedArgs) > 0 {
-		if len(topics) != len(indexedArgs)+1 {
-			return Result{Error: errors.Wrap(ErrBadInput, "topic/field count mismatch")}, runInfo
-		}
-		err = abi.ParseTopicsIntoMap(out, indexedArgs, topics[1:])
-		if err != nil {
-			return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
-		}
+	out, err := decodeETHABILog(args, indexedArgs, data, topics)
+	if err != nil {
+		return Result{Error: err}, runInfo
 	}
 	return Result{Value: out}, runInfo
 }
@@ -0,0 +1,67 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     *big.Int
+//
+// Returns the balance of address in wei. block may be a block number or tag,
+// and defaults to "latest".
+//
+type ETHBalanceTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Address    string `json:"address"`
+	Block      string `json:"block"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHBalanceTask)(nil)
+
+func (t *ETHBalanceTask) Type() TaskType {
+	return TaskTypeETHBalance
+}
+
+func (t *ETHBalanceTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		address AddressParam
+		block   BlockNumberParam
+		chainID StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address))), "address"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		lggr.Errorf("Invalid chain ID %s", chainID)
+		return Result{Error: err}, runInfo
+	}
+
+	balance, err := chain.Client().BalanceAt(ctx, common.Address(address), block.BigInt())
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: balance}, runInfo
+}
@@ -0,0 +1,74 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestETHBalanceTask(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	t.Run("returns the balance at the given block", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("BalanceAt", mock.Anything, address, big.NewInt(100)).Return(big.NewInt(42), nil).Once()
+
+		task := pipeline.ETHBalanceTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethbalance", nil, nil, 0),
+			Address:  "$(address)",
+			Block:    "100",
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)))
+
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"address": address.Hex()})
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, big.NewInt(42), result.Value)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("errors are retryable", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("BalanceAt", mock.Anything, address, (*big.Int)(nil)).Return(nil, errors.New("connection reset")).Once()
+
+		task := pipeline.ETHBalanceTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethbalance", nil, nil, 0),
+			Address:  address.Hex(),
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)))
+
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "connection reset")
+		assert.True(t, runInfo.IsRetryable)
+	})
+
+	t.Run("bad address", func(t *testing.T) {
+		task := pipeline.ETHBalanceTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethbalance", nil, nil, 0),
+			Address:  "0xDeaDbeef",
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, new(evmmocks.Client), evmtest.NewChainScopedConfig(t, cfg)))
+
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
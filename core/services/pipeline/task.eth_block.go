@@ -0,0 +1,105 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     map[string]interface{} with the keys:
+//         number        *big.Int
+//         hash          common.Hash
+//         parentHash    common.Hash
+//         timestamp     uint64
+//         baseFeePerGas *big.Int (nil before EIP-1559)
+//         gasLimit      uint64
+//         gasUsed       uint64
+//         difficulty    *big.Int
+//         miner         common.Address
+//
+// block may be a block number or tag, and defaults to "latest".
+//
+type ETHBlockTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Block      string `json:"block"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHBlockTask)(nil)
+
+// ethBlockHeader holds the header fields of an eth_getBlockByNumber response.
+// The header is decoded directly rather than through go-ethereum, which
+// rejects headers from some chains.
+type ethBlockHeader struct {
+	Number        *hexutil.Big   `json:"number"`
+	Hash          common.Hash    `json:"hash"`
+	ParentHash    common.Hash    `json:"parentHash"`
+	Timestamp     hexutil.Uint64 `json:"timestamp"`
+	BaseFeePerGas *hexutil.Big   `json:"baseFeePerGas"`
+	GasLimit      hexutil.Uint64 `json:"gasLimit"`
+	GasUsed       hexutil.Uint64 `json:"gasUsed"`
+	Difficulty    *hexutil.Big   `json:"difficulty"`
+	Miner         common.Address `json:"miner"`
+}
+
+func (t *ETHBlockTask) Type() TaskType {
+	return TaskTypeETHBlock
+}
+
+func (t *ETHBlockTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		block   BlockNumberParam
+		chainID StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		lggr.Errorf("Invalid chain ID %s", chainID)
+		return Result{Error: err}, runInfo
+	}
+
+	var header *ethBlockHeader
+	blockArg := evmclient.ToBlockNumArg(block.BigInt())
+	err = chain.Client().CallContext(ctx, &header, "eth_getBlockByNumber", blockArg, false)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	} else if header == nil || header.Number == nil {
+		return Result{Error: errors.Errorf("block %s not found", blockArg)}, retryableRunInfo()
+	}
+
+	return Result{Value: map[string]interface{}{
+		"number":        header.Number.ToInt(),
+		"hash":          header.Hash,
+		"parentHash":    header.ParentHash,
+		"timestamp":     uint64(header.Timestamp),
+		"baseFeePerGas": (*big.Int)(header.BaseFeePerGas),
+		"gasLimit":      uint64(header.GasLimit),
+		"gasUsed":       uint64(header.GasUsed),
+		"difficulty":    (*big.Int)(header.Difficulty),
+		"miner":         header.Miner,
+	}}, runInfo
+}
@@ -0,0 +1,84 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestETHBlockTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	header := `{
+		"number": "0x3039",
+		"hash": "0x1111111111111111111111111111111111111111111111111111111111111111",
+		"parentHash": "0x2222222222222222222222222222222222222222222222222222222222222222",
+		"timestamp": "0x61c0e1d0",
+		"baseFeePerGas": "0x3b9aca00",
+		"gasLimit": "0x1c9c380",
+		"gasUsed": "0x5208",
+		"difficulty": "0x2",
+		"miner": "0x3333333333333333333333333333333333333333"
+	}`
+
+	newTask := func(t *testing.T, ethClient *evmmocks.Client, block string) pipeline.ETHBlockTask {
+		task := pipeline.ETHBlockTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethblock", nil, nil, 0),
+			Block:    block,
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)))
+		return task
+	}
+
+	t.Run("returns header fields", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "0x3039", false).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				require.NoError(t, json.Unmarshal([]byte(header), args.Get(1)))
+			}).
+			Once()
+
+		task := newTask(t, ethClient, "12345")
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, map[string]interface{}{
+			"number":        big.NewInt(12345),
+			"hash":          common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111"),
+			"parentHash":    common.HexToHash("0x2222222222222222222222222222222222222222222222222222222222222222"),
+			"timestamp":     uint64(1640030672),
+			"baseFeePerGas": big.NewInt(1000000000),
+			"gasLimit":      uint64(30000000),
+			"gasUsed":       uint64(21000),
+			"difficulty":    big.NewInt(2),
+			"miner":         common.HexToAddress("0x3333333333333333333333333333333333333333"),
+		}, result.Value)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_getBlockByNumber", "latest", false).Return(nil).Once()
+
+		task := newTask(t, ethClient, "")
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "block latest not found")
+		assert.True(t, runInfo.IsRetryable)
+	})
+}
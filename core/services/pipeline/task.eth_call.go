@@ -20,6 +20,10 @@ import (
 // Return types:
 //     []byte
 //
+// block may be a block number, one of the tags "latest", "pending" and
+// "earliest", or a variable such as $(jobRun.logBlockNumber). The call is made
+// against the latest block by default.
+//
 type ETHCallTask struct {
 	BaseTask            `mapstructure:",squash"`
 	Contract            string `json:"contract"`
@@ -30,6 +34,7 @@ type ETHCallTask struct {
 	GasFeeCap           string `json:"gasFeeCap"`
 	ExtractRevertReason bool   `json:"extractRevertReason"`
 	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
+	Block               string `json:"block"`
 
 	chainSet evm.ChainSet
 	config   Config
@@ -64,6 +69,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		gasTipCap    MaybeBigIntParam
 		gasFeeCap    MaybeBigIntParam
 		chainID      StringParam
+		block        BlockNumberParam
 	)
 
 	err = multierr.Combine(
@@ -74,6 +80,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&gasTipCap, From(VarExpr(t.GasTipCap, vars), t.GasTipCap)), "gasTipCap"),
 		errors.Wrap(ResolveParam(&gasFeeCap, From(VarExpr(t.GasFeeCap, vars), t.GasFeeCap)), "gasFeeCap"),
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -93,7 +100,8 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	lggr = lggr.With("gas", call.Gas).
 		With("gasPrice", call.GasPrice).
 		With("gasTipCap", call.GasTipCap).
-		With("gasFeeCap", call.GasFeeCap)
+		With("gasFeeCap", call.GasFeeCap).
+		With("block", block.BigInt())
 
 	chain, err := getChainByString(t.chainSet, string(chainID))
 	if err != nil {
@@ -102,7 +110,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	}
 
 	start := time.Now()
-	resp, err := chain.Client().CallContract(ctx, call, nil)
+	resp, err := chain.Client().CallContract(ctx, call, block.BigInt())
 	elapsed := time.Since(start)
 	if err != nil {
 		if t.ExtractRevertReason {
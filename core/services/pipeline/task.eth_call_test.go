@@ -173,3 +173,48 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_Block(t *testing.T) {
+	t.Parallel()
+
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	tests := []struct {
+		name     string
+		block    string
+		vars     pipeline.Vars
+		expected *big.Int
+	}{
+		{"latest by default", "", pipeline.NewVarsFrom(nil), nil},
+		{"block number", "12345", pipeline.NewVarsFrom(nil), big.NewInt(12345)},
+		{"pending", "pending", pipeline.NewVarsFrom(nil), big.NewInt(-1)},
+		{"variable", "$(jobRun.logBlockNumber)", pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"logBlockNumber": uint64(12345)},
+		}), big.NewInt(12345)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClient := new(evmmocks.Client)
+			ethClient.Test(t)
+			ethClient.
+				On("CallContract", mock.Anything, ethereum.CallMsg{To: &contractAddr, Data: []byte("foo bar")}, test.expected).
+				Return([]byte("baz quux"), nil).
+				Once()
+
+			cfg := configtest.NewTestGeneralConfig(t)
+			task := pipeline.ETHCallTask{
+				BaseTask: pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+				Contract: contractAddr.Hex(),
+				Data:     "0x666f6f20626172",
+				Block:    test.block,
+			}
+			task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)), cfg)
+
+			result, _ := task.Run(context.Background(), logger.TestLogger(t), test.vars, nil)
+			require.NoError(t, result.Error)
+			require.Equal(t, []byte("baz quux"), result.Value)
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
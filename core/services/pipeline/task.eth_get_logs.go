@@ -0,0 +1,150 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     []interface{} of map[string]interface{}, one per log, with the keys:
+//         address, topics, data, blockNumber, blockHash, transactionHash, logIndex
+//     and, if abi is given, args: map[string]interface{} with any geth/abigen value type
+//
+// Queries the logs emitted by address (or by any contract, if omitted) between
+// fromBlock and toBlock inclusive. Both default to the latest block, which is
+// pinned to a block number before querying. topics is a JSON list of topics to
+// match at each position. If abi is an event signature such as
+// "Transfer(address indexed from, address indexed to, uint256 value)", logs
+// are decoded with it and, unless topics is given, filtered by its event
+// signature.
+//
+type ETHGetLogsTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Address    string `json:"address"`
+	Topics     string `json:"topics"`
+	FromBlock  string `json:"fromBlock"`
+	ToBlock    string `json:"toBlock"`
+	ABI        string `json:"abi"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHGetLogsTask)(nil)
+
+func (t *ETHGetLogsTask) Type() TaskType {
+	return TaskTypeETHGetLogs
+}
+
+func (t *ETHGetLogsTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		topics    HashSliceParam
+		fromBlock BlockNumberParam
+		toBlock   BlockNumberParam
+		theABI    StringParam
+		chainID   StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&topics, From(VarExpr(t.Topics, vars), JSONWithVarExprs(t.Topics, vars, false), nil)), "topics"),
+		errors.Wrap(ResolveParam(&fromBlock, From(VarExpr(t.FromBlock, vars), t.FromBlock)), "fromBlock"),
+		errors.Wrap(ResolveParam(&toBlock, From(VarExpr(t.ToBlock, vars), t.ToBlock)), "toBlock"),
+		errors.Wrap(ResolveParam(&theABI, From(t.ABI)), "abi"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	query := ethereum.FilterQuery{}
+	if strings.TrimSpace(t.Address) != "" {
+		var address AddressParam
+		err = ResolveParam(&address, From(VarExpr(t.Address, vars), NonemptyString(t.Address)))
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "address")}, runInfo
+		}
+		query.Addresses = []common.Address{common.Address(address)}
+	}
+	for _, topic := range topics {
+		query.Topics = append(query.Topics, []common.Hash{topic})
+	}
+
+	var args, indexedArgs abi.Arguments
+	if theABI != "" {
+		var name string
+		name, args, indexedArgs, err = parseETHABIString([]byte(theABI), true)
+		if err != nil {
+			return Result{Error: errors.Wrap(ErrBadInput, err.Error())}, runInfo
+		}
+		if len(query.Topics) == 0 {
+			query.Topics = [][]common.Hash{{abi.NewEvent(name, name, false, args).ID}}
+		}
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		lggr.Errorf("Invalid chain ID %s", chainID)
+		return Result{Error: err}, runInfo
+	}
+
+	query.FromBlock, query.ToBlock = fromBlock.BigInt(), toBlock.BigInt()
+	if fromBlock.IsLatest() || toBlock.IsLatest() {
+		head, err := chain.Client().HeadByNumber(ctx, nil)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "unable to fetch latest block number")}, retryableRunInfo()
+		} else if head == nil {
+			return Result{Error: errors.New("unable to fetch latest block number: got nil head")}, retryableRunInfo()
+		}
+		if fromBlock.IsLatest() {
+			query.FromBlock = big.NewInt(head.Number)
+		}
+		if toBlock.IsLatest() {
+			query.ToBlock = big.NewInt(head.Number)
+		}
+	}
+
+	lggr = lggr.With("fromBlock", query.FromBlock, "toBlock", query.ToBlock, "addresses", query.Addresses)
+
+	logs, err := chain.Client().FilterLogs(ctx, query)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	lggr.Debugw("ETHGetLogs task: fetched logs", "count", len(logs))
+
+	results := make([]interface{}, len(logs))
+	for i, log := range logs {
+		out := map[string]interface{}{
+			"address":         log.Address,
+			"topics":          log.Topics,
+			"data":            log.Data,
+			"blockNumber":     log.BlockNumber,
+			"blockHash":       log.BlockHash,
+			"transactionHash": log.TxHash,
+			"logIndex":        log.Index,
+		}
+		if theABI != "" {
+			decoded, err := decodeETHABILog(args, indexedArgs, log.Data, log.Topics)
+			if err != nil {
+				return Result{Error: errors.Wrapf(err, "unable to decode log %d", i)}, runInfo
+			}
+			out["args"] = decoded
+		}
+		results[i] = out
+	}
+	return Result{Value: results}, runInfo
+}
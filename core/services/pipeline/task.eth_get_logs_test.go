@@ -0,0 +1,121 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestETHGetLogsTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	contract := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	log := types.Log{
+		Address:     contract,
+		Topics:      []common.Hash{transferTopic, from.Hash(), to.Hash()},
+		Data:        common.BigToHash(big.NewInt(42)).Bytes(),
+		BlockNumber: 100,
+		BlockHash:   common.HexToHash("0xabc"),
+		TxHash:      common.HexToHash("0xdef"),
+		Index:       3,
+	}
+
+	newTask := func(t *testing.T, ethClient *evmmocks.Client, task pipeline.ETHGetLogsTask) pipeline.ETHGetLogsTask {
+		task.BaseTask = pipeline.NewBaseTask(0, "ethgetlogs", nil, nil, 0)
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)))
+		return task
+	}
+
+	t.Run("queries and decodes logs by event signature", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 200}, nil).Once()
+		ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+			FromBlock: big.NewInt(100),
+			ToBlock:   big.NewInt(200),
+			Addresses: []common.Address{contract},
+			Topics:    [][]common.Hash{{transferTopic}},
+		}).Return([]types.Log{log}, nil).Once()
+
+		task := newTask(t, ethClient, pipeline.ETHGetLogsTask{
+			Address:   contract.Hex(),
+			FromBlock: "$(jobRun.logBlockNumber)",
+			ABI:       "Transfer(address indexed from, address indexed to, uint256 value)",
+		})
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{"logBlockNumber": uint64(100)},
+		})
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"address":         contract,
+				"topics":          log.Topics,
+				"data":            log.Data,
+				"blockNumber":     uint64(100),
+				"blockHash":       log.BlockHash,
+				"transactionHash": log.TxHash,
+				"logIndex":        uint(3),
+				"args": map[string]interface{}{
+					"from":  from,
+					"to":    to,
+					"value": big.NewInt(42),
+				},
+			},
+		}, result.Value)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("raw logs by topic", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{
+			FromBlock: big.NewInt(10),
+			ToBlock:   big.NewInt(20),
+			Topics:    [][]common.Hash{{transferTopic}, {from.Hash()}},
+		}).Return([]types.Log{log}, nil).Once()
+
+		task := newTask(t, ethClient, pipeline.ETHGetLogsTask{
+			Topics:    `["` + transferTopic.Hex() + `", "` + from.Hash().Hex() + `"]`,
+			FromBlock: "10",
+			ToBlock:   "20",
+		})
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		require.Len(t, result.Value, 1)
+		assert.NotContains(t, result.Value.([]interface{})[0], "args")
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("bad abi", func(t *testing.T) {
+		task := newTask(t, new(evmmocks.Client), pipeline.ETHGetLogsTask{
+			FromBlock: "10",
+			ToBlock:   "20",
+			ABI:       "Transfer(address indexed)",
+		})
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		assert.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+}
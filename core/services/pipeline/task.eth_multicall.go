@@ -0,0 +1,204 @@
+package pipeline
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     []interface{} with one element per call, each either []byte or, if an
+//     ABI is given, map[string]interface{} with any geth/abigen value type
+//
+// calls is a JSON list of objects with the keys "contract", "data" and
+// optionally "abi", e.g.
+//
+//     calls=<[
+//         {"contract": "0x...", "data": $(encode_balance), "abi": "uint256 balance"},
+//         {"contract": "0x...", "data": "0x18160ddd"}
+//     ]>
+//
+// All calls are sent in a single batch RPC request and executed against the
+// same block. If block is "latest" (the default), it is pinned to the latest
+// block number before making the calls. The top-level abi is used for calls
+// that do not specify their own.
+//
+type ETHMultiCallTask struct {
+	BaseTask            `mapstructure:",squash"`
+	Calls               string `json:"calls"`
+	ABI                 string `json:"abi"`
+	Gas                 string `json:"gas"`
+	Block               string `json:"block"`
+	ExtractRevertReason bool   `json:"extractRevertReason"`
+	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHMultiCallTask)(nil)
+
+type ethMultiCall struct {
+	contract AddressParam
+	data     BytesParam
+	abi      StringParam
+}
+
+func (t *ETHMultiCallTask) Type() TaskType {
+	return TaskTypeETHMultiCall
+}
+
+func (t *ETHMultiCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		callsParam SliceParam
+		defaultABI StringParam
+		gas        Uint64Param
+		block      BlockNumberParam
+		chainID    StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&callsParam, From(VarExpr(t.Calls, vars), JSONWithVarExprs(t.Calls, vars, false))), "calls"),
+		errors.Wrap(ResolveParam(&defaultABI, From(t.ABI)), "abi"),
+		errors.Wrap(ResolveParam(&gas, From(VarExpr(t.Gas, vars), NonemptyString(t.Gas), 0)), "gas"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	} else if len(callsParam) == 0 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "calls param must not be empty")}, runInfo
+	}
+
+	calls, err := t.parseCalls(callsParam, defaultABI)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "calls")}, runInfo
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		lggr.Errorf("Invalid chain ID %s", chainID)
+		return Result{Error: err}, runInfo
+	}
+
+	blockNumber := block.BigInt()
+	if block.IsLatest() {
+		head, err := chain.Client().HeadByNumber(ctx, nil)
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "unable to fetch latest block number")}, retryableRunInfo()
+		} else if head == nil {
+			return Result{Error: errors.New("unable to fetch latest block number: got nil head")}, retryableRunInfo()
+		}
+		blockNumber = big.NewInt(head.Number)
+	}
+	blockArg := evmclient.ToBlockNumArg(blockNumber)
+
+	lggr = lggr.With("gas", uint64(gas)).
+		With("block", blockArg).
+		With("calls", len(calls))
+
+	responses := make([]hexutil.Bytes, len(calls))
+	reqs := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		callArg := map[string]interface{}{
+			"to":   common.Address(call.contract),
+			"data": hexutil.Bytes(call.data),
+		}
+		if gas > 0 {
+			callArg["gas"] = hexutil.Uint64(gas)
+		}
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{callArg, blockArg},
+			Result: &responses[i],
+		}
+	}
+
+	start := time.Now()
+	err = chain.Client().BatchCallContext(ctx, reqs)
+	elapsed := time.Since(start)
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	for i, req := range reqs {
+		if req.Error != nil {
+			err = req.Error
+			if t.ExtractRevertReason {
+				err = t.retrieveRevertReason(err, lggr)
+			}
+			return Result{Error: errors.Wrapf(err, "call %d to %s", i, common.Address(calls[i].contract))}, retryableRunInfo()
+		}
+	}
+
+	promETHCallTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+
+	results := make([]interface{}, len(calls))
+	for i, call := range calls {
+		if call.abi == "" {
+			results[i] = []byte(responses[i])
+			continue
+		}
+		args, _, err := ParseETHABIArgsString([]byte(call.abi), false)
+		if err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "call %d: %v", i, err)}, runInfo
+		}
+		out := make(map[string]interface{})
+		if len(responses[i]) > 0 {
+			if err := args.UnpackIntoMap(out, responses[i]); err != nil {
+				return Result{Error: errors.Wrapf(err, "call %d: unable to decode result", i)}, runInfo
+			}
+		}
+		results[i] = out
+	}
+
+	return Result{Value: results}, runInfo
+}
+
+func (t *ETHMultiCallTask) parseCalls(callsParam SliceParam, defaultABI StringParam) ([]ethMultiCall, error) {
+	calls := make([]ethMultiCall, len(callsParam))
+	for i, c := range callsParam {
+		callMap, ok := c.(map[string]interface{})
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "call %d: expected object, got %T", i, c)
+		}
+		call := ethMultiCall{abi: defaultABI}
+		err := multierr.Combine(
+			errors.Wrap(call.contract.UnmarshalPipelineParam(callMap["contract"]), "contract"),
+			errors.Wrap(call.data.UnmarshalPipelineParam(callMap["data"]), "data"),
+		)
+		if abi, exists := callMap["abi"]; exists {
+			err = multierr.Append(err, errors.Wrap(call.abi.UnmarshalPipelineParam(abi), "abi"))
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "call %d", i)
+		} else if len(call.data) == 0 {
+			return nil, errors.Wrapf(ErrBadInput, "call %d: data must not be empty", i)
+		}
+		calls[i] = call
+	}
+	return calls, nil
+}
+
+func (t *ETHMultiCallTask) retrieveRevertReason(baseErr error, lggr logger.Logger) error {
+	reason, err := evmclient.ExtractRevertReasonFromRPCError(baseErr)
+	if err != nil {
+		lggr.Errorw("failed to extract revert reason", "baseErr", baseErr, "error", err)
+		return baseErr
+	}
+
+	return errors.Wrap(baseErr, reason)
+}
@@ -0,0 +1,133 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestETHMultiCallTask(t *testing.T) {
+	t.Parallel()
+
+	contract1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	contract2 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	answer := common.LeftPadBytes(big.NewInt(42).Bytes(), 32)
+
+	// batchCall mocks a batch of eth_calls, checking that every call is made
+	// against blockArg, and answers them with responses in order
+	batchCall := func(t *testing.T, ethClient *evmmocks.Client, blockArg string, responses ...[]byte) {
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			if len(b) != len(responses) {
+				return false
+			}
+			for _, elem := range b {
+				if elem.Method != "eth_call" || elem.Args[1] != blockArg {
+					return false
+				}
+			}
+			return true
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			for i, elem := range elems {
+				if responses[i] == nil {
+					elems[i].Error = errors.New("execution reverted")
+					continue
+				}
+				*elem.Result.(*hexutil.Bytes) = responses[i]
+			}
+		}).Once()
+	}
+
+	newTask := func(t *testing.T, ethClient *evmmocks.Client, calls, abi, block string) pipeline.ETHMultiCallTask {
+		cfg := configtest.NewTestGeneralConfig(t)
+		task := pipeline.ETHMultiCallTask{
+			BaseTask: pipeline.NewBaseTask(0, "ethmulticall", nil, nil, 0),
+			Calls:    calls,
+			ABI:      abi,
+			Block:    block,
+		}
+		task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)))
+		return task
+	}
+
+	calls := `[
+		{"contract": "0x1111111111111111111111111111111111111111", "data": $(data1), "abi": "uint256 answer"},
+		{"contract": "0x2222222222222222222222222222222222222222", "data": "0x18160ddd"}
+	]`
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"data1": []byte{0xfe, 0xaf, 0x96, 0x8c}})
+
+	t.Run("pins latest to the current block", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 12345}, nil).Once()
+		batchCall(t, ethClient, "0x3039", answer, []byte{0x01})
+
+		task := newTask(t, ethClient, calls, "", "")
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.False(t, runInfo.IsRetryable)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"answer": big.NewInt(42)},
+			[]byte{0x01},
+		}, result.Value)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("uses the given block and default abi", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		batchCall(t, ethClient, "0x64", answer, answer)
+
+		task := newTask(t, ethClient, calls, "uint256 value", "100")
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"answer": big.NewInt(42)},
+			map[string]interface{}{"value": big.NewInt(42)},
+		}, result.Value)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("fails if any call fails", func(t *testing.T) {
+		ethClient := new(evmmocks.Client)
+		ethClient.Test(t)
+		batchCall(t, ethClient, "0x64", answer, nil)
+
+		task := newTask(t, ethClient, calls, "", "100")
+		result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Error(t, result.Error)
+		assert.Contains(t, result.Error.Error(), "call 1 to "+contract2.Hex())
+		assert.True(t, runInfo.IsRetryable)
+		ethClient.AssertExpectations(t)
+	})
+
+	t.Run("bad calls", func(t *testing.T) {
+		for _, calls := range []string{
+			`[]`,
+			`["0x1111111111111111111111111111111111111111"]`,
+			`[{"contract": "0x11", "data": "0x18160ddd"}]`,
+			`[{"contract": "` + contract1.Hex() + `"}]`,
+		} {
+			task := newTask(t, new(evmmocks.Client), calls, "", "")
+			result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+			require.Error(t, result.Error, calls)
+			assert.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error), calls)
+		}
+	})
+}
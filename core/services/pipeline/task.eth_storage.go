@@ -0,0 +1,74 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//     []byte
+//
+// Returns the 32 byte word stored at slot in contract's storage. slot may be
+// given as a hex string or an integer. block may be a block number or tag,
+// and defaults to "latest".
+//
+type ETHStorageTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Contract   string `json:"contract"`
+	Slot       string `json:"slot"`
+	Block      string `json:"block"`
+	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+
+	chainSet evm.ChainSet
+}
+
+var _ Task = (*ETHStorageTask)(nil)
+
+func (t *ETHStorageTask) Type() TaskType {
+	return TaskTypeETHStorage
+}
+
+func (t *ETHStorageTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		contract AddressParam
+		slot     HashParam
+		block    BlockNumberParam
+		chainID  StringParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&contract, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
+		errors.Wrap(ResolveParam(&slot, From(VarExpr(t.Slot, vars), NonemptyString(t.Slot))), "slot"),
+		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	chain, err := getChainByString(t.chainSet, string(chainID))
+	if err != nil {
+		lggr.Errorf("Invalid chain ID %s", chainID)
+		return Result{Error: err}, runInfo
+	}
+
+	var value hexutil.Bytes
+	err = chain.Client().CallContext(ctx, &value, "eth_getStorageAt", common.Address(contract), common.Hash(slot), evmclient.ToBlockNumArg(block.BigInt()))
+	if err != nil {
+		return Result{Error: err}, retryableRunInfo()
+	}
+	return Result{Value: []byte(value)}, runInfo
+}
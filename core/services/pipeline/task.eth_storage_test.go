@@ -0,0 +1,67 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestETHStorageTask(t *testing.T) {
+	t.Parallel()
+
+	contract := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	word := common.BigToHash(common.Big3).Bytes()
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	slot := common.BytesToHash([]byte{5})
+
+	tests := []struct {
+		name        string
+		slot        string
+		block       string
+		expectedArg string
+	}{
+		{"integer slot at latest block", "5", "", "latest"},
+		{"hex slot at given block", "0x5", "100", "0x64"},
+		{"hash slot", slot.Hex(), "pending", "pending"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ethClient := new(evmmocks.Client)
+			ethClient.Test(t)
+			ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_getStorageAt", contract, slot, test.expectedArg).
+				Return(nil).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*hexutil.Bytes) = word
+				}).
+				Once()
+
+			task := pipeline.ETHStorageTask{
+				BaseTask: pipeline.NewBaseTask(0, "ethstorage", nil, nil, 0),
+				Contract: contract.Hex(),
+				Slot:     test.slot,
+				Block:    test.block,
+			}
+			task.HelperSetDependencies(cltest.NewChainSetMockWithOneChain(t, ethClient, evmtest.NewChainScopedConfig(t, cfg)))
+
+			result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+			assert.Equal(t, word, result.Value)
+			ethClient.AssertExpectations(t)
+		})
+	}
+}
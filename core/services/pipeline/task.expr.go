@@ -0,0 +1,44 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// ExprTask evaluates a sandboxed expression. Pipeline variables are referenced
+// with the usual $(...) syntax, and the task's input (if any) is available as
+// `input`. For example:
+//
+//    check [type=expr expr="ifelse(abs($(a) - $(b)) / $(b) > 0.05, fail(`deviation too large`), $(a))"];
+//
+// Return types:
+//    decimal.Decimal
+//    bool
+//    string
+//
+type ExprTask struct {
+	BaseTask `mapstructure:",squash"`
+	Expr     string `json:"expr"`
+}
+
+var _ Task = (*ExprTask)(nil)
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	value, err := EvalExpr(t.Expr, vars, inputs)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expr")}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,145 @@
+package pipeline_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestExprTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"a":   "100.5",
+		"b":   float64(100),
+		"foo": map[string]interface{}{"bar": int64(42), "name": "Chainlink", "ok": true},
+	})
+
+	tests := []struct {
+		name   string
+		expr   string
+		inputs []pipeline.Result
+		want   interface{}
+	}{
+		{"arithmetic", "$(a) * 2 - 1", nil, *mustDecimal(t, "200")},
+		{"precedence", "1 + 2 * 3", nil, *mustDecimal(t, "7")},
+		{"parentheses", "(1 + 2) * 3", nil, *mustDecimal(t, "9")},
+		{"division", "$(foo.bar) / 4", nil, *mustDecimal(t, "10.5")},
+		{"remainder", "$(foo.bar) % 5", nil, *mustDecimal(t, "2")},
+		{"negation", "-$(b)", nil, *mustDecimal(t, "-100")},
+		{"hex literal", "0x10 + 1", nil, *mustDecimal(t, "17")},
+		{"input", "input * 10", []pipeline.Result{{Value: "1.23"}}, *mustDecimal(t, "12.3")},
+		{"deviation", "abs($(a) - $(b)) / $(b) > 0.05", nil, false},
+		{"comparison", "$(a) >= $(b)", nil, true},
+		{"equality", "$(foo.bar) == 42", nil, true},
+		{"logical", "$(foo.ok) && !($(b) < 10)", nil, true},
+		{"short circuit", "false && $(foo.name) > 1", nil, false},
+		{"min", "min($(a), $(b), 200)", nil, *mustDecimal(t, "100")},
+		{"max", "max($(a), $(b), 1)", nil, *mustDecimal(t, "100.5")},
+		{"clamp low", "clamp(-5, 0, 10)", nil, *mustDecimal(t, "0")},
+		{"clamp high", "clamp(15, 0, 10)", nil, *mustDecimal(t, "10")},
+		{"round", "round(2.345, 2)", nil, *mustDecimal(t, "2.35")},
+		{"round to integer", "round($(a))", nil, *mustDecimal(t, "101")},
+		{"scale and round", "round(input * 100000000)", []pipeline.Result{{Value: "1.234567891"}}, *mustDecimal(t, "123456789")},
+		{"floor", "floor($(a))", nil, *mustDecimal(t, "100")},
+		{"ceil", "ceil($(a))", nil, *mustDecimal(t, "101")},
+		{"string concat", `$(foo.name) + " node"`, nil, "Chainlink node"},
+		{"string functions", "upper(trim(`  link `))", nil, "LINK"},
+		{"string predicates", "contains(lower($(foo.name)), `link`) && hasPrefix($(foo.name), `Chain`)", nil, true},
+		{"len", "len($(foo.name))", nil, *mustDecimal(t, "9")},
+		{"ifelse", "ifelse($(a) > $(b), `up`, `down`)", nil, "up"},
+		{"ifelse does not evaluate the other branch", "ifelse(true, 1, fail(`nope`))", nil, *mustDecimal(t, "1")},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expr:     test.expr,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			if want, isDecimal := test.want.(decimal.Decimal); isDecimal {
+				require.Equal(t, want.String(), result.Value.(decimal.Decimal).String())
+			} else {
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_Unhappy(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"foo":     map[string]interface{}{"bar": "chainlink"},
+		"errored": errors.New("uh oh"),
+		"huge":    "1e999999999",
+	})
+
+	tests := []struct {
+		name              string
+		expr              string
+		inputs            []pipeline.Result
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"empty", "", nil, pipeline.ErrParameterEmpty, "expr"},
+		{"syntax error", "1 +", nil, pipeline.ErrExprInvalid, "expr"},
+		{"missing var", "$(baz) + 1", nil, pipeline.ErrKeypathNotFound, "expr"},
+		{"errored var", "$(errored) + 1", nil, pipeline.ErrTooManyErrors, "expr"},
+		{"unknown identifier", "os", nil, pipeline.ErrExprInvalid, "unknown identifier"},
+		{"unknown function", "exec(`ls`)", nil, pipeline.ErrExprInvalid, "unknown function"},
+		{"selector", "$(foo.bar).Len", nil, pipeline.ErrExprInvalid, "unsupported expression"},
+		{"func literal", "func() int { return 1 }()", nil, pipeline.ErrExprInvalid, "unsupported"},
+		{"non-numeric arithmetic", "$(foo.bar) * 2", nil, pipeline.ErrBadInput, "expected number"},
+		{"non-bool logic", "1 && true", nil, pipeline.ErrBadInput, "expected bool"},
+		{"division by zero", "1 / 0", nil, pipeline.ErrBadInput, "division by zero"},
+		{"wrong arity", "abs(1, 2)", nil, pipeline.ErrWrongInputCardinality, "abs"},
+		{"clamp bounds", "clamp(1, 10, 0)", nil, pipeline.ErrBadInput, "lower bound"},
+		{"fail", "ifelse(2 > 1, fail(`deviation too large`), 1)", nil, pipeline.ErrTaskRunFailed, "deviation too large"},
+		{"input without inputs", "input + 1", nil, pipeline.ErrWrongInputCardinality, "input"},
+		{"errored input", "input + 1", []pipeline.Result{{Error: errors.New("uh oh")}}, pipeline.ErrTooManyErrors, "task inputs"},
+		{"too many inputs", "1", []pipeline.Result{{Value: 1}, {Value: 2}}, pipeline.ErrWrongInputCardinality, "task inputs"},
+		{"huge exponent", "1e999999999 + 1", nil, pipeline.ErrExprInvalid, "exponent"},
+		{"huge negative exponent", "1e-999999999 * 2", nil, pipeline.ErrExprInvalid, "exponent"},
+		{"huge exponent var", "$(huge) + 1", nil, pipeline.ErrExprInvalid, "exponent"},
+		{"huge exponent input", "input * 2", []pipeline.Result{{Value: "1e999999999"}}, pipeline.ErrExprInvalid, "exponent"},
+		{"exponent out of range after multiplication", "1e600 * 1e600", nil, pipeline.ErrExprInvalid, "exponent"},
+		{"too many digits", strings.Repeat("9", 1001), nil, pipeline.ErrExprInvalid, "digits"},
+		{"too many digits after addition", "1e1000 + 1", nil, pipeline.ErrExprInvalid, "digits"},
+		{"repeated multiplication", strings.Repeat("99999999999999999999 * ", 60) + "1", nil, pipeline.ErrExprInvalid, "digits"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExprTask{
+				BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Expr:     test.expr,
+			}
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+		})
+	}
+}
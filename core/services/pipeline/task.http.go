@@ -2,7 +2,10 @@ package pipeline
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"math"
+	"net/http"
 
 	"go.uber.org/multierr"
 
@@ -16,11 +19,27 @@ import (
 // Return types:
 //     string
 //
+// Headers is a JSON object of header names to values, and may reference
+// pipeline variables, e.g. headers=<{"X-API-Key": $(apiKey)}>. Header values
+// are never logged. If basicAuthUsername is set, an Authorization header is
+// sent with the given username and password.
+//
+// SizeLimit overrides the node's DEFAULT_HTTP_LIMIT for this task. If
+// cacheTTL is set, successful responses are cached in memory for that long
+// and identical requests are served from the cache, unless the cached
+// response was fetched with allowUnrestrictedNetworkAccess and this task does
+// not allow it, or is larger than this task's size limit.
+//
 type HTTPTask struct {
 	BaseTask                       `mapstructure:",squash"`
 	Method                         string
 	URL                            string
 	RequestData                    string `json:"requestData"`
+	Headers                        string `json:"headers"`
+	BasicAuthUsername              string `json:"basicAuthUsername"`
+	BasicAuthPassword              string `json:"basicAuthPassword"`
+	SizeLimit                      string `json:"sizeLimit"`
+	CacheTTL                       string `json:"cacheTTL"`
 	AllowUnrestrictedNetworkAccess string
 
 	config Config
@@ -57,33 +76,84 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		method                         StringParam
 		url                            URLParam
 		requestData                    MapParam
+		headers                        StringMapParam
+		basicAuthUsername              StringParam
+		basicAuthPassword              StringParam
+		sizeLimit                      MaybeUint64Param
+		cacheTTL                       MaybeDurationParam
 		allowUnrestrictedNetworkAccess BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
 		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
 		errors.Wrap(ResolveParam(&requestData, From(VarExpr(t.RequestData, vars), JSONWithVarExprs(t.RequestData, vars, false), nil)), "requestData"),
+		errors.Wrap(ResolveParam(&headers, From(VarExpr(t.Headers, vars), JSONWithVarExprs(t.Headers, vars, false), nil)), "headers"),
+		errors.Wrap(ResolveParam(&basicAuthUsername, From(VarExpr(t.BasicAuthUsername, vars), t.BasicAuthUsername)), "basicAuthUsername"),
+		errors.Wrap(ResolveParam(&basicAuthPassword, From(VarExpr(t.BasicAuthPassword, vars), t.BasicAuthPassword)), "basicAuthPassword"),
+		errors.Wrap(ResolveParam(&sizeLimit, From(t.SizeLimit)), "sizeLimit"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(t.CacheTTL)), "cacheTTL"),
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
 
+	requestHeaders := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		requestHeaders[http.CanonicalHeaderKey(k)] = v
+	}
+	if basicAuthUsername != "" {
+		if _, exists := requestHeaders["Authorization"]; exists {
+			return Result{Error: errors.Wrap(ErrBadInput, "cannot set both basicAuthUsername and an Authorization header")}, runInfo
+		}
+		credentials := base64.StdEncoding.EncodeToString([]byte(string(basicAuthUsername) + ":" + string(basicAuthPassword)))
+		requestHeaders["Authorization"] = "Basic " + credentials
+	}
+
+	httpLimit := t.config.DefaultHTTPLimit()
+	if limit, isSet := sizeLimit.Uint64(); isSet {
+		if limit == 0 || limit > math.MaxInt64 {
+			return Result{Error: errors.Wrapf(ErrBadInput, "sizeLimit: must be between 1 and %v", int64(math.MaxInt64))}, runInfo
+		}
+		httpLimit = int64(limit)
+	}
+
 	requestDataJSON, err := json.Marshal(requestData)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	headerNames := make([]string, 0, len(requestHeaders))
+	for k := range requestHeaders {
+		headerNames = append(headerNames, k)
+	}
 	lggr.Debugw("HTTP task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
 		"method", method,
+		"headers", headerNames,
 		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
 	)
 
+	ttl, cacheEnabled := cacheTTL.Duration()
+	cacheEnabled = cacheEnabled && ttl > 0
+	var cacheKey string
+	if cacheEnabled {
+		cacheKey, err = httpCacheKey(method, url, requestData, requestHeaders)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+		if responseBytes, exists := httpCache.Get(cacheKey, bool(allowUnrestrictedNetworkAccess), httpLimit); exists {
+			promHTTPCacheHits.WithLabelValues(t.DotID()).Inc()
+			lggr.Debugw("HTTP task: using cached response", "url", url.String(), "dotID", t.DotID())
+			return Result{Value: string(responseBytes)}, runInfo
+		}
+		promHTTPCacheMisses.WithLabelValues(t.DotID()).Inc()
+	}
+
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	responseBytes, statusCode, _, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, requestData, allowUnrestrictedNetworkAccess, t.config.DefaultHTTPLimit())
+	responseBytes, statusCode, _, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, requestData, requestHeaders, allowUnrestrictedNetworkAccess, httpLimit)
 	if err != nil {
 		if errors.Cause(err) == ErrDisallowedIP {
 			err = errors.Wrap(err, "connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess=true in the pipeline task spec")
@@ -91,6 +161,10 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
 
+	if cacheEnabled {
+		httpCache.Set(cacheKey, responseBytes, bool(allowUnrestrictedNetworkAccess), ttl)
+	}
+
 	lggr.Debugw("HTTP task got response",
 		"response", string(responseBytes),
 		"url", url.String(),
@@ -3,9 +3,11 @@ package pipeline_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync/atomic"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -295,3 +297,145 @@ func TestHTTPTask_OnlyErrorMessage(t *testing.T) {
 	require.Contains(t, result.Error.Error(), "RequestId")
 	require.Nil(t, result.Value)
 }
+
+func TestHTTPTask_HeadersAndBasicAuth(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	var gotHeaders http.Header
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte("{}"))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method:            "GET",
+		URL:               server.URL,
+		Headers:           `{"x-api-key": $(apiKey), "Content-Type": "text/plain"}`,
+		BasicAuthUsername: "alice",
+		BasicAuthPassword: "$(password)",
+	}
+	task.HelperSetDependencies(config)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"apiKey": "s3cr3t", "password": "hunter2"})
+	result, runInfo := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	assert.False(t, runInfo.IsPending)
+	assert.False(t, runInfo.IsRetryable)
+	require.NoError(t, result.Error)
+
+	require.Equal(t, "s3cr3t", gotHeaders.Get("X-Api-Key"))
+	require.Equal(t, "text/plain", gotHeaders.Get("Content-Type"))
+	username, password, ok := (&http.Request{Header: gotHeaders}).BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "alice", username)
+	require.Equal(t, "hunter2", password)
+
+	t.Run("basic auth conflicts with Authorization header", func(t *testing.T) {
+		task.Headers = `{"authorization": "Bearer foo"}`
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+	})
+
+	t.Run("non-string header value", func(t *testing.T) {
+		task.Headers = `{"X-Count": 1}`
+		task.BasicAuthUsername = ""
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "headers")
+	})
+}
+
+func TestHTTPTask_SizeLimit(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"result": "0123456789"}`))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method:    "GET",
+		URL:       server.URL,
+		SizeLimit: "10",
+	}
+	task.HelperSetDependencies(config)
+
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	require.Contains(t, result.Error.Error(), "http: request body too large")
+
+	task.SizeLimit = "1024"
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, `{"result": "0123456789"}`, result.Value)
+
+	task.SizeLimit = "0"
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+}
+
+func TestHTTPTask_CacheTTL(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	var requests int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(fmt.Sprintf(`{"n": %d}`, n)))
+		require.NoError(t, err)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	task := pipeline.HTTPTask{
+		Method:   "GET",
+		URL:      server.URL,
+		Headers:  `{"X-Api-Key": "a"}`,
+		CacheTTL: "1h",
+	}
+	task.HelperSetDependencies(config)
+
+	result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, `{"n": 1}`, result.Value)
+
+	// served from the cache
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, `{"n": 1}`, result.Value)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// cached responses over the size limit are not served
+	task.SizeLimit = "4"
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	task.SizeLimit = ""
+
+	// different headers are a different cache entry
+	task.Headers = `{"X-Api-Key": "b"}`
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, `{"n": 3}`, result.Value)
+
+	// caching disabled
+	task.CacheTTL = ""
+	result, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, `{"n": 4}`, result.Value)
+}
@@ -14,10 +14,18 @@ import (
 // Return types:
 //    *decimal.Decimal
 //
+// Outliers can be dropped before aggregating by setting outlierMAD,
+// outlierIQR, maxDeviation and minSources (see outlierFilter). The dropped
+// values are recorded in the task run details.
+//
 type MeanTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	OutlierMAD    string `json:"outlierMAD"`
+	OutlierIQR    string `json:"outlierIQR"`
+	MaxDeviation  string `json:"maxDeviation"`
+	MinSources    string `json:"minSources"`
 	Precision     string `json:"precision"`
 }
 
@@ -44,6 +52,10 @@ func (t *MeanTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	outliers, err := resolveOutlierFilter(vars, t.OutlierMAD, t.OutlierIQR, t.MaxDeviation, t.MinSources)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
 
 	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
 		allowedFaults = int(allowed)
@@ -63,17 +75,22 @@ func (t *MeanTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		return Result{Error: errors.Wrapf(ErrBadInput, "values: %v", err)}, runInfo
 	}
 
+	kept, details, err := outliers.apply(decimalValues, nonErrorIndices(valuesAndErrs))
+	if err != nil {
+		return Result{Error: err, Details: details}, runInfo
+	}
+
 	total := decimal.NewFromInt(0)
-	for _, val := range decimalValues {
+	for _, val := range kept {
 		total = total.Add(val)
 	}
 
-	numValues := decimal.NewFromInt(int64(len(decimalValues)))
+	numValues := decimal.NewFromInt(int64(len(kept)))
 
 	if precision, isSet := maybePrecision.Int32(); isSet {
-		return Result{Value: total.DivRound(numValues, precision)}, runInfo
+		return Result{Value: total.DivRound(numValues, precision), Details: details}, runInfo
 	}
 	// Note that decimal library defaults to rounding to 16 precision
 	//https://github.com/shopspring/decimal/blob/2568a29459476f824f35433dfbef158d6ad8618c/decimal.go#L44
-	return Result{Value: total.Div(numValues)}, runInfo
+	return Result{Value: total.Div(numValues), Details: details}, runInfo
 }
@@ -224,3 +224,24 @@ func TestMeanTask(t *testing.T) {
 		})
 	}
 }
+
+func TestMeanTask_Outliers(t *testing.T) {
+	t.Parallel()
+
+	task := pipeline.MeanTask{
+		BaseTask:   pipeline.NewBaseTask(0, "task", nil, nil, 0),
+		OutlierMAD: "3",
+		MinSources: "3",
+	}
+	inputs := []pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "102")}, {Value: mustDecimal(t, "1000")}, {Value: mustDecimal(t, "98")}}
+
+	output, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+	require.NoError(t, output.Error)
+	require.Equal(t, "100", output.Value.(decimal.Decimal).String())
+	require.NotNil(t, output.Details)
+	require.Len(t, output.Details["dropped"], 1)
+
+	task.MinSources = "4"
+	output, _ = task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), inputs)
+	require.Equal(t, pipeline.ErrWrongInputCardinality, errors.Cause(output.Error))
+}
@@ -2,10 +2,8 @@ package pipeline
 
 import (
 	"context"
-	"sort"
 
 	"github.com/pkg/errors"
-	"github.com/shopspring/decimal"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -15,10 +13,18 @@ import (
 // Return types:
 //    *decimal.Decimal
 //
+// Outliers can be dropped before aggregating by setting outlierMAD,
+// outlierIQR, maxDeviation and minSources (see outlierFilter). The dropped
+// values are recorded in the task run details.
+//
 type MedianTask struct {
 	BaseTask      `mapstructure:",squash"`
 	Values        string `json:"values"`
 	AllowedFaults string `json:"allowedFaults"`
+	OutlierMAD    string `json:"outlierMAD"`
+	OutlierIQR    string `json:"outlierIQR"`
+	MaxDeviation  string `json:"maxDeviation"`
+	MinSources    string `json:"minSources"`
 }
 
 var _ Task = (*MedianTask)(nil)
@@ -42,6 +48,10 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	outliers, err := resolveOutlierFilter(vars, t.OutlierMAD, t.OutlierIQR, t.MaxDeviation, t.MinSources)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
 
 	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
 		allowedFaults = int(allowed)
@@ -61,13 +71,9 @@ func (t *MedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs [
 		return Result{Error: err}, runInfo
 	}
 
-	sort.Slice(decimalValues, func(i, j int) bool {
-		return decimalValues[i].LessThan(decimalValues[j])
-	})
-	k := len(decimalValues) / 2
-	if len(decimalValues)%2 == 1 {
-		return Result{Value: decimalValues[k]}, runInfo
+	kept, details, err := outliers.apply(decimalValues, nonErrorIndices(valuesAndErrs))
+	if err != nil {
+		return Result{Error: err, Details: details}, runInfo
 	}
-	median := decimalValues[k].Add(decimalValues[k-1]).Div(decimal.NewFromInt(2))
-	return Result{Value: median}, runInfo
+	return Result{Value: medianOf(kept), Details: details}, runInfo
 }
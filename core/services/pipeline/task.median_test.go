@@ -2,6 +2,7 @@ package pipeline_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -214,3 +215,109 @@ func TestMedian_AllowedFaults_Unmarshal(t *testing.T) {
 		}
 	}
 }
+
+func TestMedian_Outliers(t *testing.T) {
+	t.Parallel()
+
+	values := func(vals ...string) []pipeline.Result {
+		var results []pipeline.Result
+		for _, val := range vals {
+			if val == "error" {
+				results = append(results, pipeline.Result{Error: errors.New("")})
+			} else {
+				results = append(results, pipeline.Result{Value: mustDecimal(t, val)})
+			}
+		}
+		return results
+	}
+
+	tests := []struct {
+		name           string
+		inputs         []pipeline.Result
+		task           pipeline.MedianTask
+		want           string
+		wantErr        error
+		droppedIndices []int
+	}{
+		{
+			"no outlier options",
+			values("1", "2", "100"),
+			pipeline.MedianTask{},
+			"2", nil, nil,
+		},
+		{
+			"mad",
+			values("100", "101", "99", "100", "500"),
+			pipeline.MedianTask{OutlierMAD: "3"},
+			"100", nil, []int{4},
+		},
+		{
+			"iqr",
+			values("100", "101", "99", "100", "500", "0"),
+			pipeline.MedianTask{OutlierIQR: "1.5"},
+			"100", nil, []int{4, 5},
+		},
+		{
+			"max deviation",
+			values("100", "102", "98", "110"),
+			pipeline.MedianTask{MaxDeviation: "0.05"},
+			"100", nil, []int{3},
+		},
+		{
+			"indices skip errored inputs",
+			values("100", "error", "101", "99", "500"),
+			pipeline.MedianTask{MaxDeviation: "0.05", AllowedFaults: "1"},
+			"100", nil, []int{4},
+		},
+		{
+			"zero mad keeps all values",
+			values("100", "100", "100", "101"),
+			pipeline.MedianTask{OutlierMAD: "3"},
+			"100", nil, []int{},
+		},
+		{
+			"too few sources left",
+			values("100", "200", "300"),
+			pipeline.MedianTask{MaxDeviation: "0.1", MinSources: "2"},
+			"", pipeline.ErrWrongInputCardinality, []int{0, 2},
+		},
+		{
+			"negative threshold",
+			values("1", "2", "3"),
+			pipeline.MedianTask{OutlierMAD: "-1"},
+			"", pipeline.ErrBadInput, nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := test.task
+			task.BaseTask = pipeline.NewBaseTask(0, "task", nil, nil, 0)
+			output, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+			} else {
+				require.NoError(t, output.Error)
+				require.Equal(t, test.want, output.Value.(decimal.Decimal).String())
+			}
+
+			if test.droppedIndices == nil {
+				require.Nil(t, output.Details)
+				return
+			}
+			require.NotNil(t, output.Details)
+			b, err := json.Marshal(output.Details["dropped"])
+			require.NoError(t, err)
+			var dropped []struct{ Index int }
+			require.NoError(t, json.Unmarshal(b, &dropped))
+			indices := []int{}
+			for _, d := range dropped {
+				indices = append(indices, d.Index)
+			}
+			assert.Equal(t, test.droppedIndices, indices)
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
@@ -499,7 +501,7 @@ func (a *AddressParam) UnmarshalPipelineParam(val interface{}) error {
 	case string:
 		return a.UnmarshalPipelineParam([]byte(v))
 	case []byte:
-		if bytes.Equal(v[:2], []byte("0x")) && len(v) == 42 {
+		if len(v) == 42 && bytes.Equal(v[:2], []byte("0x")) {
 			*a = AddressParam(common.HexToAddress(string(v)))
 			return nil
 		} else if len(v) == 20 {
@@ -642,6 +644,46 @@ func (s *DecimalSliceParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// HashParam is a 32 byte word, given as a hash, a hex string of at most 32
+// bytes (which is left padded) or an unsigned integer.
+type HashParam common.Hash
+
+func (h *HashParam) UnmarshalPipelineParam(val interface{}) error {
+	switch v := val.(type) {
+	case common.Hash:
+		*h = HashParam(v)
+		return nil
+	case []byte:
+		if len(v) != common.HashLength {
+			return errors.Wrapf(ErrBadInput, "expected %d bytes, got %d", common.HashLength, len(v))
+		}
+		*h = HashParam(common.BytesToHash(v))
+		return nil
+	case string:
+		if utils.HasHexPrefix(v) {
+			bs, err := hexutil.Decode(v)
+			if err != nil && errors.Cause(err) == hexutil.ErrOddLength {
+				bs, err = hexutil.Decode("0x0" + v[2:])
+			}
+			if err != nil {
+				return errors.Wrapf(ErrBadInput, "expected hash, got %s: %v", v, err)
+			} else if len(bs) > common.HashLength {
+				return errors.Wrapf(ErrBadInput, "expected at most %d bytes, got %d", common.HashLength, len(bs))
+			}
+			*h = HashParam(common.BytesToHash(bs))
+			return nil
+		}
+	}
+	var n MaybeBigIntParam
+	if err := n.UnmarshalPipelineParam(val); err != nil || n.BigInt() == nil {
+		return errors.Wrapf(ErrBadInput, "expected hash, got %T", val)
+	} else if n.BigInt().Sign() < 0 || n.BigInt().BitLen() > 256 {
+		return errors.Wrapf(ErrBadInput, "expected unsigned 256 bit integer, got %s", n.BigInt())
+	}
+	*h = HashParam(common.BigToHash(n.BigInt()))
+	return nil
+}
+
 type HashSliceParam []common.Hash
 
 func (s *HashSliceParam) UnmarshalPipelineParam(val interface{}) error {
@@ -804,3 +846,130 @@ func (p *MaybeBigIntParam) UnmarshalPipelineParam(val interface{}) error {
 func (p MaybeBigIntParam) BigInt() *big.Int {
 	return p.n
 }
+
+// BlockNumberParam is a block number or one of the block tags "latest",
+// "pending" and "earliest". An empty value means "latest".
+type BlockNumberParam struct {
+	n *big.Int
+}
+
+// pendingBlockNumber is how go-ethereum represents the "pending" block tag
+var pendingBlockNumber = big.NewInt(-1)
+
+func (p *BlockNumberParam) UnmarshalPipelineParam(val interface{}) error {
+	var n *big.Int
+	switch v := val.(type) {
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "", "latest":
+			*p = BlockNumberParam{nil}
+			return nil
+		case "pending":
+			*p = BlockNumberParam{new(big.Int).Set(pendingBlockNumber)}
+			return nil
+		case "earliest":
+			*p = BlockNumberParam{big.NewInt(0)}
+			return nil
+		}
+		var ok bool
+		if utils.HasHexPrefix(v) {
+			n, ok = new(big.Int).SetString(utils.RemoveHexPrefix(v), 16)
+		} else {
+			n, ok = new(big.Int).SetString(v, 10)
+		}
+		if !ok {
+			return errors.Wrapf(ErrBadInput, "expected block number or tag, got %s", v)
+		}
+	case nil:
+		*p = BlockNumberParam{nil}
+		return nil
+	default:
+		var bn MaybeBigIntParam
+		if err := bn.UnmarshalPipelineParam(val); err != nil {
+			return errors.Wrapf(ErrBadInput, "expected block number or tag, got %T", val)
+		}
+		n = bn.BigInt()
+	}
+	if n.Sign() < 0 {
+		return errors.Wrapf(ErrBadInput, "block number must not be negative, got %s", n)
+	}
+	*p = BlockNumberParam{n}
+	return nil
+}
+
+// BigInt returns the block number in the form expected by the go-ethereum
+// client: nil for "latest" and -1 for "pending".
+func (p BlockNumberParam) BigInt() *big.Int {
+	return p.n
+}
+
+// IsLatest reports whether p refers to the latest block
+func (p BlockNumberParam) IsLatest() bool {
+	return p.n == nil
+}
+
+// IsPending reports whether p refers to the pending block
+func (p BlockNumberParam) IsPending() bool {
+	return p.n != nil && p.n.Cmp(pendingBlockNumber) == 0
+}
+
+type MaybeDurationParam struct {
+	d     time.Duration
+	isSet bool
+}
+
+func (p *MaybeDurationParam) UnmarshalPipelineParam(val interface{}) error {
+	var d time.Duration
+	switch v := val.(type) {
+	case time.Duration:
+		d = v
+	case string:
+		if strings.TrimSpace(v) == "" {
+			*p = MaybeDurationParam{0, false}
+			return nil
+		}
+		var err error
+		d, err = time.ParseDuration(v)
+		if err != nil {
+			return errors.Wrap(ErrBadInput, err.Error())
+		}
+	case nil:
+		*p = MaybeDurationParam{0, false}
+		return nil
+	default:
+		return errors.Wrapf(ErrBadInput, "expected duration or nil, got %T", val)
+	}
+	if d < 0 {
+		return errors.Wrapf(ErrBadInput, "duration must not be negative, got %v", d)
+	}
+
+	*p = MaybeDurationParam{d, true}
+	return nil
+}
+
+func (p MaybeDurationParam) Duration() (time.Duration, bool) {
+	return p.d, p.isSet
+}
+
+type StringMapParam map[string]string
+
+func (m *StringMapParam) UnmarshalPipelineParam(val interface{}) error {
+	var mp MapParam
+	if err := mp.UnmarshalPipelineParam(val); err != nil {
+		return err
+	}
+	if mp == nil {
+		*m = nil
+		return nil
+	}
+	sm := make(StringMapParam, len(mp))
+	for k, v := range mp {
+		var s StringParam
+		if err := s.UnmarshalPipelineParam(v); err != nil {
+			return errors.Wrapf(ErrBadInput, "value for key %q: expected string, got %T", k, v)
+		}
+		sm[k] = string(s)
+	}
+	*m = sm
+	return nil
+}
@@ -2,8 +2,10 @@ package pipeline_test
 
 import (
 	"encoding/base64"
+	"math/big"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -433,3 +435,147 @@ func TestJSONPathParam_UnmarshalPipelineParam(t *testing.T) {
 		})
 	}
 }
+
+func TestMaybeDurationParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected time.Duration
+		isSet    bool
+		err      error
+	}{
+		{"string", "1m30s", 90 * time.Second, true, nil},
+		{"duration", 5 * time.Second, 5 * time.Second, true, nil},
+		{"empty string", "", 0, false, nil},
+		{"nil", nil, 0, false, nil},
+		{"bad string", "forever", 0, false, pipeline.ErrBadInput},
+		{"negative", "-1s", 0, false, pipeline.ErrBadInput},
+		{"int", 123, 0, false, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.MaybeDurationParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			d, isSet := p.Duration()
+			require.Equal(t, test.expected, d)
+			require.Equal(t, test.isSet, isSet)
+		})
+	}
+}
+
+func TestBlockNumberParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected *big.Int
+		err      error
+	}{
+		{"empty string", "", nil, nil},
+		{"nil", nil, nil, nil},
+		{"latest", "latest", nil, nil},
+		{"pending", "pending", big.NewInt(-1), nil},
+		{"earliest", "earliest", big.NewInt(0), nil},
+		{"decimal string", "12345", big.NewInt(12345), nil},
+		{"hex string", "0x3039", big.NewInt(12345), nil},
+		{"uint64", uint64(12345), big.NewInt(12345), nil},
+		{"int64", int64(12345), big.NewInt(12345), nil},
+		{"float64", float64(12345), big.NewInt(12345), nil},
+		{"*big.Int", big.NewInt(12345), big.NewInt(12345), nil},
+		{"negative", int64(-1), nil, pipeline.ErrBadInput},
+		{"negative string", "-1", nil, pipeline.ErrBadInput},
+		{"bad tag", "safe-ish", nil, pipeline.ErrBadInput},
+		{"bool", true, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.BlockNumberParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			if test.err == nil {
+				require.Equal(t, test.expected, p.BigInt())
+				require.Equal(t, test.expected == nil, p.IsLatest())
+			}
+		})
+	}
+}
+
+func TestHashParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	hash := common.HexToHash("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	five := common.BigToHash(big.NewInt(5))
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected common.Hash
+		err      error
+	}{
+		{"hash", hash, hash, nil},
+		{"32 bytes", hash.Bytes(), hash, nil},
+		{"hex string", hash.Hex(), hash, nil},
+		{"short hex string", "0x5", five, nil},
+		{"decimal string", "5", five, nil},
+		{"int", 5, five, nil},
+		{"*big.Int", big.NewInt(5), five, nil},
+		{"short bytes", []byte{5}, common.Hash{}, pipeline.ErrBadInput},
+		{"long hex string", hash.Hex() + "00", common.Hash{}, pipeline.ErrBadInput},
+		{"bad hex string", "0xzz", common.Hash{}, pipeline.ErrBadInput},
+		{"negative", -5, common.Hash{}, pipeline.ErrBadInput},
+		{"nil", nil, common.Hash{}, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.HashParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, common.Hash(p))
+		})
+	}
+}
+
+func TestStringMapParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected pipeline.StringMapParam
+		err      error
+	}{
+		{"map", map[string]interface{}{"foo": "bar"}, pipeline.StringMapParam{"foo": "bar"}, nil},
+		{"json string", `{"foo": "bar"}`, pipeline.StringMapParam{"foo": "bar"}, nil},
+		{"nil", nil, nil, nil},
+		{"non-string value", map[string]interface{}{"foo": 1}, nil, pipeline.ErrBadInput},
+		{"not a map", 123, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var p pipeline.StringMapParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p)
+		})
+	}
+}
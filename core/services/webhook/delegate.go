@@ -2,14 +2,19 @@ package webhook
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/xeipuuv/gojsonschema"
 
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -28,11 +33,11 @@ type (
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, lggr logger.Logger) *Delegate {
+func NewDelegate(runner pipeline.Runner, pipelineORM pipeline.ORM, externalInitiatorManager ExternalInitiatorManager, lggr logger.Logger) *Delegate {
 	lggr = lggr.Named("Webhook")
 	return &Delegate{
 		externalInitiatorManager: externalInitiatorManager,
-		webhookJobRunner:         newWebhookJobRunner(runner, lggr),
+		webhookJobRunner:         newWebhookJobRunner(runner, pipelineORM, lggr),
 		lggr:                     lggr,
 	}
 }
@@ -100,20 +105,23 @@ type webhookJobRunner struct {
 	specsByUUID   map[uuid.UUID]registeredJob
 	muSpecsByUUID sync.RWMutex
 	runner        pipeline.Runner
+	pipelineORM   pipeline.ORM
 	lggr          logger.Logger
 }
 
-func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJobRunner {
+func newWebhookJobRunner(runner pipeline.Runner, pipelineORM pipeline.ORM, lggr logger.Logger) *webhookJobRunner {
 	return &webhookJobRunner{
 		specsByUUID: make(map[uuid.UUID]registeredJob),
 		runner:      runner,
+		pipelineORM: pipelineORM,
 		lggr:        lggr.Named("JobRunner"),
 	}
 }
 
 type registeredJob struct {
 	job.Job
-	chRemove chan struct{}
+	inputSchema *gojsonschema.Schema
+	chRemove    chan struct{}
 }
 
 func (r *webhookJobRunner) addSpec(spec job.Job) error {
@@ -123,7 +131,15 @@ func (r *webhookJobRunner) addSpec(spec job.Job) error {
 	if exists {
 		return errors.Errorf("a webhook job with that UUID already exists (uuid: %v)", spec.ExternalJobID)
 	}
-	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{})}
+	var inputSchema *gojsonschema.Schema
+	if spec.WebhookSpec != nil && spec.WebhookSpec.InputSchema.Valid {
+		var err error
+		inputSchema, err = compileInputSchema(spec.WebhookSpec.InputSchema.String)
+		if err != nil {
+			return err
+		}
+	}
+	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, inputSchema, make(chan struct{})}
 	return nil
 }
 
@@ -144,22 +160,39 @@ func (r *webhookJobRunner) spec(externalJobID uuid.UUID) (registeredJob, bool) {
 	return spec, exists
 }
 
-var ErrJobNotExists = errors.New("job does not exist")
+var (
+	ErrJobNotExists = errors.New("job does not exist")
+	// ErrInvalidInput is returned when the request body of a run is not
+	// valid against the input schema of the job. No run is created.
+	ErrInvalidInput = errors.New("request body does not match the input schema")
+	// ErrSyncTimeout is returned along with the run ID, if it has one yet,
+	// when the run of a sync job did not finish within the sync timeout. The
+	// run carries on.
+	ErrSyncTimeout = errors.New("timed out waiting for the run to finish")
+)
+
+// syncPollInterval is how often a run request of a sync job checks whether
+// a suspended run has finished.
+const syncPollInterval = 500 * time.Millisecond
 
+// RunJob runs the pipeline of a webhook job with the given request body. If
+// the job is sync, it waits for the run to finish, including any async
+// tasks, before returning.
 func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
+	start := time.Now()
 	spec, exists := r.spec(jobUUID)
 	if !exists {
 		return 0, ErrJobNotExists
 	}
+	if err := spec.validateInput(requestBody); err != nil {
+		return 0, err
+	}
 
 	jobLggr := r.lggr.With(
 		"jobID", spec.ID,
 		"uuid", spec.ExternalJobID,
 	)
 
-	ctx, cancel := utils.CombinedContext(ctx, spec.chRemove)
-	defer cancel()
-
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    spec.ID,
@@ -174,6 +207,13 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 
 	run := pipeline.NewRun(*spec.PipelineSpec, vars)
 
+	if spec.WebhookSpec != nil && spec.WebhookSpec.Sync {
+		return r.runSync(ctx, jobLggr, spec, &run, start.Add(spec.WebhookSpec.SyncTimeout))
+	}
+
+	ctx, cancel := utils.CombinedContext(ctx, spec.chRemove)
+	defer cancel()
+
 	_, err := r.runner.Run(ctx, &run, jobLggr, true, nil)
 	if err != nil {
 		jobLggr.Errorw("Error running pipeline for webhook job", "error", err)
@@ -184,3 +224,104 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 	}
 	return run.ID, nil
 }
+
+type syncRunResult struct {
+	runID      int64
+	incomplete bool
+	err        error
+}
+
+// runSync runs the pipeline of a sync job and waits for it to finish, until
+// the deadline. The run is detached from the request, so that it carries on
+// after the deadline and ErrSyncTimeout is returned along with the run ID.
+// Runs without async tasks are only saved once they finish, so if the
+// deadline passes before then no run ID is returned.
+func (r *webhookJobRunner) runSync(ctx context.Context, lggr logger.Logger, spec registeredJob, run *pipeline.Run, deadline time.Time) (int64, error) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	chCreated := make(chan int64, 1)
+	chDone := make(chan syncRunResult, 1)
+	go func() {
+		runCtx, cancelRun := utils.ContextFromChan(spec.chRemove)
+		defer cancelRun()
+		incomplete, err := r.runner.Run(runCtx, run, lggr, true, func(pg.Queryer) error {
+			// Runs with async tasks are saved before they start
+			if run.ID != 0 {
+				chCreated <- run.ID
+			}
+			return nil
+		})
+		if err != nil {
+			lggr.Errorw("Error running pipeline for webhook job", "error", err)
+		}
+		chDone <- syncRunResult{run.ID, incomplete, err}
+	}()
+
+	var runID int64
+	for {
+		select {
+		case runID = <-chCreated:
+		case result := <-chDone:
+			if result.err != nil {
+				return 0, result.err
+			}
+			if result.runID == 0 {
+				panic("expected run to have non-zero id")
+			}
+			if result.incomplete {
+				return result.runID, r.waitForRun(ctx, result.runID)
+			}
+			return result.runID, nil
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return runID, ErrSyncTimeout
+			}
+			return runID, ctx.Err()
+		}
+	}
+}
+
+// waitForRun waits for a suspended run to be resumed and finish, until ctx
+// is done.
+func (r *webhookJobRunner) waitForRun(ctx context.Context, runID int64) error {
+	ticker := time.NewTicker(syncPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrSyncTimeout
+			}
+			return ctx.Err()
+		case <-ticker.C:
+			run, err := r.pipelineORM.FindRun(runID)
+			if err != nil {
+				return errors.Wrapf(err, "failed to load run %d", runID)
+			}
+			if run.FinishedAt.Valid {
+				return nil
+			}
+		}
+	}
+}
+
+// validateInput checks the request body of a run against the input schema
+// of the job, if it has one.
+func (j registeredJob) validateInput(requestBody string) error {
+	if j.inputSchema == nil {
+		return nil
+	}
+	result, err := j.inputSchema.Validate(gojsonschema.NewStringLoader(requestBody))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidInput, err)
+	}
+	if !result.Valid() {
+		var msgs []string
+		for _, resultErr := range result.Errors() {
+			msgs = append(msgs, resultErr.String())
+		}
+		return fmt.Errorf("%w: %s", ErrInvalidInput, strings.Join(msgs, "; "))
+	}
+	return nil
+}
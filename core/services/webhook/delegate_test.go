@@ -3,6 +3,7 @@ package webhook_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
@@ -44,9 +46,10 @@ func TestWebhookDelegate(t *testing.T) {
 				"meta":        meta.Val,
 			},
 		}
-		runner    = new(pipelinemocks.Runner)
-		eiManager = new(webhookmocks.ExternalInitiatorManager)
-		delegate  = webhook.NewDelegate(runner, eiManager, logger.TestLogger(t))
+		runner      = new(pipelinemocks.Runner)
+		pipelineORM = new(pipelinemocks.ORM)
+		eiManager   = new(webhookmocks.ExternalInitiatorManager)
+		delegate    = webhook.NewDelegate(runner, pipelineORM, eiManager, logger.TestLogger(t))
 	)
 
 	services, err := delegate.ServicesForSpec(*spec)
@@ -94,3 +97,154 @@ func TestWebhookDelegate(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func startWebhookJob(t *testing.T, runner pipeline.Runner, pipelineORM pipeline.ORM, spec job.WebhookSpec) (job.Job, webhook.JobRunner) {
+	jb := job.Job{
+		ID:            123,
+		Type:          job.Webhook,
+		SchemaVersion: 1,
+		ExternalJobID: uuid.NewV4(),
+		WebhookSpec:   &spec,
+		PipelineSpec:  &pipeline.Spec{},
+	}
+	delegate := webhook.NewDelegate(runner, pipelineORM, new(webhookmocks.ExternalInitiatorManager), logger.TestLogger(t))
+	services, err := delegate.ServicesForSpec(jb)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.NoError(t, services[0].Start())
+	t.Cleanup(func() { require.NoError(t, services[0].Close()) })
+	return jb, delegate.WebhookJobRunner()
+}
+
+func TestWebhookDelegate_InputSchema(t *testing.T) {
+	runner := new(pipelinemocks.Runner)
+	jb, jobRunner := startWebhookJob(t, runner, new(pipelinemocks.ORM), job.WebhookSpec{
+		InputSchema: null.StringFrom(`{"type": "object", "required": ["amount"], "properties": {"amount": {"type": "integer"}}}`),
+	})
+
+	_, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, `{"amount": "foo"}`, pipeline.JSONSerializable{})
+	require.True(t, errors.Is(err, webhook.ErrInvalidInput))
+	require.Contains(t, err.Error(), "amount")
+
+	_, err = jobRunner.RunJob(context.Background(), jb.ExternalJobID, `not json`, pipeline.JSONSerializable{})
+	require.True(t, errors.Is(err, webhook.ErrInvalidInput))
+
+	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*pipeline.Run).ID = 1
+		}).Once()
+
+	runID, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, `{"amount": 42}`, pipeline.JSONSerializable{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), runID)
+
+	runner.AssertExpectations(t)
+}
+
+func TestWebhookDelegate_Sync(t *testing.T) {
+	suspendRun := func(runner *pipelinemocks.Runner) {
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(true, nil).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*pipeline.Run).ID = 1
+			}).Once()
+	}
+
+	t.Run("waits for a suspended run to finish", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		pipelineORM := new(pipelinemocks.ORM)
+		jb, jobRunner := startWebhookJob(t, runner, pipelineORM, job.WebhookSpec{Sync: true, SyncTimeout: 10 * time.Second})
+
+		suspendRun(runner)
+		pipelineORM.On("FindRun", int64(1)).Return(pipeline.Run{ID: 1}, nil).Once()
+		pipelineORM.On("FindRun", int64(1)).Return(pipeline.Run{ID: 1, FinishedAt: null.TimeFrom(time.Now())}, nil).Once()
+
+		runID, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, "", pipeline.JSONSerializable{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), runID)
+
+		runner.AssertExpectations(t)
+		pipelineORM.AssertExpectations(t)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		pipelineORM := new(pipelinemocks.ORM)
+		jb, jobRunner := startWebhookJob(t, runner, pipelineORM, job.WebhookSpec{Sync: true, SyncTimeout: 100 * time.Millisecond})
+
+		suspendRun(runner)
+
+		runID, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, "", pipeline.JSONSerializable{})
+		require.Equal(t, webhook.ErrSyncTimeout, err)
+		require.Equal(t, int64(1), runID)
+
+		runner.AssertExpectations(t)
+	})
+
+	t.Run("times out during a slow synchronous run", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		jb, jobRunner := startWebhookJob(t, runner, new(pipelinemocks.ORM), job.WebhookSpec{Sync: true, SyncTimeout: 100 * time.Millisecond})
+
+		finish := make(chan struct{})
+		finished := make(chan struct{})
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).
+			Run(func(args mock.Arguments) {
+				defer close(finished)
+				// Saved before it starts, like runs with async tasks
+				args.Get(1).(*pipeline.Run).ID = 1
+				require.NoError(t, args.Get(4).(func(pg.Queryer) error)(nil))
+				<-finish
+				// The run carries on after the timeout
+				require.NoError(t, args.Get(0).(context.Context).Err())
+			}).Once()
+
+		start := time.Now()
+		runID, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, "", pipeline.JSONSerializable{})
+		require.Equal(t, webhook.ErrSyncTimeout, err)
+		require.Equal(t, int64(1), runID)
+		require.Less(t, time.Since(start), time.Second)
+
+		close(finish)
+		<-finished
+		runner.AssertExpectations(t)
+	})
+
+	t.Run("times out before a slow synchronous run is saved", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		jb, jobRunner := startWebhookJob(t, runner, new(pipelinemocks.ORM), job.WebhookSpec{Sync: true, SyncTimeout: 100 * time.Millisecond})
+
+		finish := make(chan struct{})
+		finished := make(chan struct{})
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).
+			Run(func(args mock.Arguments) {
+				defer close(finished)
+				require.NoError(t, args.Get(4).(func(pg.Queryer) error)(nil))
+				<-finish
+				args.Get(1).(*pipeline.Run).ID = 1
+			}).Once()
+
+		runID, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, "", pipeline.JSONSerializable{})
+		require.Equal(t, webhook.ErrSyncTimeout, err)
+		require.Equal(t, int64(0), runID)
+
+		close(finish)
+		<-finished
+		runner.AssertExpectations(t)
+	})
+
+	t.Run("does not wait if the job is not sync", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		jb, jobRunner := startWebhookJob(t, runner, new(pipelinemocks.ORM), job.WebhookSpec{})
+
+		suspendRun(runner)
+
+		runID, err := jobRunner.RunJob(context.Background(), jb.ExternalJobID, "", pipeline.JSONSerializable{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), runID)
+
+		runner.AssertExpectations(t)
+	})
+}
@@ -1,9 +1,13 @@
 package webhook
 
 import (
+	"time"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -16,8 +20,20 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	InputSchema        null.String                        `toml:"inputSchema"`
+	Sync               bool                               `toml:"sync"`
+	SyncTimeout        time.Duration                      `toml:"syncTimeout"`
 }
 
+const (
+	// DefaultSyncTimeout is how long a run request of a sync webhook job
+	// waits for the run to finish when the spec has no syncTimeout.
+	DefaultSyncTimeout = 30 * time.Second
+	// MaxSyncTimeout bounds the syncTimeout of a webhook job, so that a run
+	// request does not hold its connection open indefinitely.
+	MaxSyncTimeout = 5 * time.Minute
+)
+
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
 	var tree *toml.Tree
 	tree, err = toml.Load(tomlString)
@@ -53,13 +69,36 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 		externalInitiatorWebhookSpecs = append(externalInitiatorWebhookSpecs, eiWS)
 	}
 
+	if tomlSpec.InputSchema.Valid {
+		if _, schemaErr := compileInputSchema(tomlSpec.InputSchema.String); schemaErr != nil {
+			err = multierr.Combine(err, schemaErr)
+		}
+	}
+
+	if !tomlSpec.Sync && tomlSpec.SyncTimeout != 0 {
+		err = multierr.Combine(err, errors.New("syncTimeout requires sync = true"))
+	} else if tomlSpec.Sync && tomlSpec.SyncTimeout == 0 {
+		tomlSpec.SyncTimeout = DefaultSyncTimeout
+	} else if tomlSpec.SyncTimeout < 0 || tomlSpec.SyncTimeout > MaxSyncTimeout {
+		err = multierr.Combine(err, errors.Errorf("syncTimeout must be between 0 and %s", MaxSyncTimeout))
+	}
+
 	if err != nil {
 		return jb, err
 	}
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		InputSchema:                   tomlSpec.InputSchema,
+		Sync:                          tomlSpec.Sync,
+		SyncTimeout:                   tomlSpec.SyncTimeout,
 	}
 
 	return jb, nil
 }
+
+// compileInputSchema parses the JSON Schema of a webhook spec.
+func compileInputSchema(inputSchema string) (*gojsonschema.Schema, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(inputSchema))
+	return schema, errors.Wrap(err, "invalid inputSchema")
+}
@@ -142,6 +142,70 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with input schema and sync",
+			toml: `
+			type            = "webhook"
+			schemaVersion   = 1
+			inputSchema     = '{"type": "object", "required": ["amount"]}'
+			sync            = true
+			observationSource   = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+				ds_parse    [type=jsonparse path="data,price"];
+				ds -> ds_parse;
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `{"type": "object", "required": ["amount"]}`, s.WebhookSpec.InputSchema.String)
+				require.True(t, s.WebhookSpec.Sync)
+				require.Equal(t, webhook.DefaultSyncTimeout, s.WebhookSpec.SyncTimeout)
+			},
+		},
+		{
+			name: "with invalid input schema",
+			toml: `
+			type            = "webhook"
+			schemaVersion   = 1
+			inputSchema     = '{"type": "notatype"}'
+			observationSource   = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid inputSchema")
+			},
+		},
+		{
+			name: "with sync timeout above the maximum",
+			toml: `
+			type            = "webhook"
+			schemaVersion   = 1
+			sync            = true
+			syncTimeout     = "1h"
+			observationSource   = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "syncTimeout must be between 0 and 5m0s")
+			},
+		},
+		{
+			name: "with sync timeout but not sync",
+			toml: `
+			type            = "webhook"
+			schemaVersion   = 1
+			syncTimeout     = "10s"
+			observationSource   = """
+				ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+			"""
+			`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.EqualError(t, err, "syncTimeout requires sync = true")
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE secrets (
+    name text PRIMARY KEY CHECK (name ~ '^[a-zA-Z][a-zA-Z0-9_]{0,63}$'),
+    encrypted_value bytea NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE secrets;
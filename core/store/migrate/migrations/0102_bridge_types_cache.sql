@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN cache_ttl bigint NOT NULL DEFAULT 0 CHECK (cache_ttl >= 0),
+    ADD COLUMN stale_if_error bigint NOT NULL DEFAULT 0 CHECK (stale_if_error >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN cache_ttl,
+    DROP COLUMN stale_if_error;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE jobs
+    ADD COLUMN run_retention_max_age bigint NOT NULL DEFAULT 0,
+    ADD COLUMN run_retention_max_failed_age bigint NOT NULL DEFAULT 0,
+    ADD COLUMN run_retention_max_runs bigint NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_run_retention_non_negative CHECK (
+        run_retention_max_age >= 0 AND run_retention_max_failed_age >= 0 AND run_retention_max_runs >= 0
+    );
+
+-- +goose Down
+ALTER TABLE jobs
+    DROP CONSTRAINT chk_run_retention_non_negative,
+    DROP COLUMN run_retention_max_age,
+    DROP COLUMN run_retention_max_failed_age,
+    DROP COLUMN run_retention_max_runs;
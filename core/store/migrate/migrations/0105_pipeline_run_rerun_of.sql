@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pipeline_runs ADD COLUMN rerun_of bigint REFERENCES pipeline_runs (id) ON DELETE SET NULL;
+CREATE INDEX idx_pipeline_runs_rerun_of ON pipeline_runs (rerun_of) WHERE rerun_of IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pipeline_runs_rerun_of;
+ALTER TABLE pipeline_runs DROP COLUMN rerun_of;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN timeout bigint NOT NULL DEFAULT 0 CHECK (timeout >= 0),
+    ADD COLUMN retries bigint NOT NULL DEFAULT 0 CHECK (retries >= 0),
+    ADD COLUMN circuit_breaker_threshold bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_threshold >= 0),
+    ADD COLUMN circuit_breaker_cooldown bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_cooldown >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN timeout,
+    DROP COLUMN retries,
+    DROP COLUMN circuit_breaker_threshold,
+    DROP COLUMN circuit_breaker_cooldown;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN request_signing text NOT NULL DEFAULT '' CHECK (request_signing IN ('', 'hmac', 'csa')),
+    ADD COLUMN response_public_key text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN request_signing,
+    DROP COLUMN response_public_key;
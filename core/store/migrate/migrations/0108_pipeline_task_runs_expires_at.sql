@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE pipeline_task_runs ADD COLUMN expires_at timestamptz;
+CREATE INDEX idx_pipeline_task_runs_expires_at ON pipeline_task_runs (expires_at) WHERE finished_at IS NULL AND expires_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pipeline_task_runs_expires_at;
+ALTER TABLE pipeline_task_runs DROP COLUMN expires_at;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE logs (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE,
+    log_index bigint NOT NULL,
+    block_hash bytea NOT NULL,
+    block_number bigint NOT NULL CHECK (block_number >= 0),
+    address bytea NOT NULL,
+    event_sig bytea NOT NULL,
+    topics bytea[] NOT NULL,
+    tx_hash bytea NOT NULL,
+    data bytea NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (block_hash, log_index, evm_chain_id)
+);
+CREATE INDEX idx_logs_chain_address_event_block ON logs (evm_chain_id, address, event_sig, block_number);
+CREATE INDEX idx_logs_chain_block_number ON logs (evm_chain_id, block_number);
+
+CREATE TABLE log_poller_blocks (
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE,
+    block_hash bytea NOT NULL,
+    block_number bigint NOT NULL CHECK (block_number >= 0),
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (block_number, evm_chain_id)
+);
+
+-- +goose Down
+DROP TABLE log_poller_blocks;
+DROP TABLE logs;
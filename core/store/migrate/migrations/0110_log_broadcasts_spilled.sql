@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE log_broadcasts_spilled (
+    id BIGSERIAL PRIMARY KEY,
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE,
+    queue text NOT NULL,
+    raw_log jsonb NOT NULL,
+    latest_block_number bigint NOT NULL,
+    latest_block_hash bytea NOT NULL,
+    forced boolean NOT NULL DEFAULT false,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX idx_log_broadcasts_spilled_chain_job_queue ON log_broadcasts_spilled (evm_chain_id, job_id, queue, id);
+
+-- +goose Down
+DROP TABLE log_broadcasts_spilled;
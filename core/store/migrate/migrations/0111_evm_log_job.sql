@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm_log_specs
+(
+    id                         BIGSERIAL PRIMARY KEY,
+    contract_address           bytea                    NOT NULL,
+    event_abi                  text                     NOT NULL,
+    topic_filters              jsonb                    NOT NULL DEFAULT '{}',
+    min_incoming_confirmations bigint,
+    evm_chain_id               numeric(78)
+        REFERENCES evm_chains
+            DEFERRABLE,
+    created_at                 timestamp with time zone NOT NULL,
+    updated_at                 timestamp with time zone NOT NULL
+        CONSTRAINT contract_address_len_chk CHECK (octet_length(contract_address) = 20)
+);
+
+ALTER TABLE jobs
+    ADD COLUMN evm_log_spec_id INT REFERENCES evm_log_specs (id),
+    DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    webhook_spec_id,
+                    vrf_spec_id,
+                    blockhash_store_spec_id,
+                    bootstrap_spec_id,
+                    evm_log_spec_id) = 1
+        );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs
+    DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    webhook_spec_id,
+                    vrf_spec_id,
+                    blockhash_store_spec_id,
+                    bootstrap_spec_id) = 1
+        );
+ALTER TABLE jobs
+    DROP COLUMN evm_log_spec_id;
+DROP TABLE IF EXISTS evm_log_specs;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE block_trigger_specs
+(
+    id             BIGSERIAL PRIMARY KEY,
+    block_interval bigint                   NOT NULL CHECK (block_interval > 0),
+    evm_chain_id   numeric(78)
+        REFERENCES evm_chains
+            DEFERRABLE,
+    created_at     timestamp with time zone NOT NULL,
+    updated_at     timestamp with time zone NOT NULL
+);
+
+ALTER TABLE jobs
+    ADD COLUMN block_trigger_spec_id INT REFERENCES block_trigger_specs (id),
+    DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    webhook_spec_id,
+                    vrf_spec_id,
+                    blockhash_store_spec_id,
+                    bootstrap_spec_id,
+                    evm_log_spec_id,
+                    block_trigger_spec_id) = 1
+        );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE jobs
+    DROP CONSTRAINT chk_only_one_spec,
+    ADD CONSTRAINT chk_only_one_spec CHECK (
+            num_nonnulls(
+                    offchainreporting_oracle_spec_id,
+                    offchainreporting2_oracle_spec_id,
+                    direct_request_spec_id,
+                    flux_monitor_spec_id,
+                    keeper_spec_id,
+                    cron_spec_id,
+                    webhook_spec_id,
+                    vrf_spec_id,
+                    blockhash_store_spec_id,
+                    bootstrap_spec_id,
+                    evm_log_spec_id) = 1
+        );
+ALTER TABLE jobs
+    DROP COLUMN block_trigger_spec_id;
+DROP TABLE IF EXISTS block_trigger_specs;
+-- +goose StatementEnd
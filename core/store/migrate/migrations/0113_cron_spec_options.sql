@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE cron_specs
+    ADD COLUMN jitter bigint NOT NULL DEFAULT 0,
+    ADD COLUMN max_concurrent_runs bigint NOT NULL DEFAULT 0,
+    ADD COLUMN concurrency_policy text NOT NULL DEFAULT 'skip',
+    ADD COLUMN catch_up bool NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE cron_specs
+    DROP COLUMN jitter,
+    DROP COLUMN max_concurrent_runs,
+    DROP COLUMN concurrency_policy,
+    DROP COLUMN catch_up;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE webhook_specs
+    ADD COLUMN input_schema text,
+    ADD COLUMN sync bool NOT NULL DEFAULT false,
+    ADD COLUMN sync_timeout bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE webhook_specs
+    DROP COLUMN input_schema,
+    DROP COLUMN sync,
+    DROP COLUMN sync_timeout;
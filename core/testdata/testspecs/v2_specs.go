@@ -160,6 +160,20 @@ observationSource   = """
 
     parse_request -> multiply -> send_to_bridge;
 """
+`
+
+	WebhookSpecWithInputSchema = `
+type            = "webhook"
+schemaVersion   = 1
+externalJobID   = "0EEC7E1D-D0D2-476C-A1A8-72DFB6633F55"
+inputSchema     = '{"type": "object", "required": ["result"], "properties": {"result": {"type": "number"}}}'
+sync            = true
+observationSource   = """
+    parse_request  [type=jsonparse path="result" data="$(jobRun.requestBody)"];
+    multiply       [type=multiply times="100"];
+
+    parse_request -> multiply;
+"""
 `
 
 	OCRBootstrapSpec = `
@@ -170,6 +184,31 @@ schemaVersion	= 1
 contractID		= "0x613a38AC1659769640aaE063C651F48E0250454C"
 [relayConfig]
 chainID			= 1337
+`
+	EVMLogSpec = `
+type                     = "evmlog"
+schemaVersion            = 1
+name                     = "example evm log spec"
+contractAddress          = "0x613a38AC1659769640aaE063C651F48E0250454C"
+eventABI                 = "Transfer(address indexed from, address indexed to, uint256 value)"
+minIncomingConfirmations = 3
+externalJobID            = "123e4567-e89b-12d3-a456-426655440021"
+observationSource        = """
+    multiply [type=multiply input="$(jobRun.logData.value)" times=2];
+"""
+
+[topicFilters]
+to = ["0xaaaa1F8ee20f5565510B84f9353F1E333E753B7a"]
+`
+	BlockTriggerSpec = `
+type              = "blocktrigger"
+schemaVersion     = 1
+name              = "example block trigger spec"
+blockInterval     = 10
+externalJobID     = "123e4567-e89b-12d3-a456-426655440022"
+observationSource = """
+    ds [type=http method=GET url="https://chain.link/health?block=$(jobRun.blockNumber)"];
+"""
 `
 )
 
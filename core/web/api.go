@@ -106,6 +106,29 @@ func getPaginatedResponseDoc(url url.URL, size, page, count int, resource interf
 	return document, nil
 }
 
+// NewCursorPaginatedResponse returns a jsonapi.Document with a link to the
+// next collection page, if nextCursor is not empty. The cursor is also
+// returned as nextCursor in the document's meta.
+func NewCursorPaginatedResponse(url url.URL, size int, nextCursor string, resource interface{}) ([]byte, error) {
+	document, err := jsonapi.MarshalToStruct(resource, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource to struct: %+v", err)
+	}
+
+	document.Meta = make(jsonapi.Meta)
+	document.Links = make(jsonapi.Links)
+	if nextCursor != "" {
+		document.Meta["nextCursor"] = nextCursor
+		query := url.Query()
+		query.Set("size", strconv.Itoa(size))
+		query.Set("cursor", nextCursor)
+		query.Del("page")
+		url.RawQuery = query.Encode()
+		document.Links[KeyNextLink] = jsonapi.Link{Href: url.String()}
+	}
+	return json.Marshal(document)
+}
+
 // ParsePaginatedResponse parse a JSONAPI response for a document with links
 func ParsePaginatedResponse(input []byte, resource interface{}, links *jsonapi.Links) error {
 	document := jsonapi.Document{}
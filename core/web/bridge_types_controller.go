@@ -49,6 +49,26 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.CacheTTL.Duration() < 0 {
+		fe.Add("CacheTTL must not be negative")
+	}
+	if bt.StaleIfError.Duration() < 0 {
+		fe.Add("StaleIfError must not be negative")
+	}
+	if bt.Timeout.Duration() < 0 {
+		fe.Add("Timeout must not be negative")
+	}
+	if bt.CircuitBreakerCooldown.Duration() < 0 {
+		fe.Add("CircuitBreakerCooldown must not be negative")
+	}
+	if err := bt.RequestSigning.Validate(); err != nil {
+		fe.Add(err.Error())
+	}
+	if bt.ResponsePublicKey != "" {
+		if _, err := bridges.ParseResponsePublicKey(bt.ResponsePublicKey); err != nil {
+			fe.Add(err.Error())
+		}
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -133,7 +153,10 @@ func (btc *BridgeTypesController) Show(c *gin.Context) {
 		return
 	}
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	resource := presenters.NewBridgeResource(bt)
+	resource.Health = presenters.NewBridgeHealthResource(btc.App.GetBridgeHealthMonitor().Status(bt.Name))
+
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Update can change the restricted attributes for a bridge
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -87,6 +88,34 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid request signing and response public key",
+			bridges.BridgeTypeRequest{
+				Name:              "signedadapter",
+				URL:               cltest.WebURL(t, "https://denergy.eth"),
+				RequestSigning:    bridges.RequestSigningCSA,
+				ResponsePublicKey: "c2a28d6f1dd7d1c5e0d4e0d1f1c2ad5e67b3c3c9f7bc0ef1b0e0a6b7dfa4a0c3",
+			},
+			nil,
+		},
+		{
+			"invalid request signing",
+			bridges.BridgeTypeRequest{
+				Name:           "signedadapter",
+				URL:            cltest.WebURL(t, "https://denergy.eth"),
+				RequestSigning: "rsa",
+			},
+			models.NewJSONAPIErrorsWith(`unknown request signing method "rsa", must be one of "hmac" or "csa"`),
+		},
+		{
+			"invalid response public key",
+			bridges.BridgeTypeRequest{
+				Name:              "signedadapter",
+				URL:               cltest.WebURL(t, "https://denergy.eth"),
+				ResponsePublicKey: "abcd",
+			},
+			models.NewJSONAPIErrorsWith("response public key must be 32 bytes, got 2"),
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -286,6 +315,9 @@ func TestBridgeController_Show(t *testing.T) {
 	assert.Equal(t, bt.Name.String(), resource.Name, "should have the same name")
 	assert.Equal(t, bt.URL.String(), resource.URL, "should have the same URL")
 	assert.Equal(t, bt.Confirmations, resource.Confirmations, "should have the same Confirmations")
+	require.NotNil(t, resource.Health)
+	assert.Equal(t, bridgehealth.StateUnknown, resource.Health.State)
+	assert.Equal(t, 0, resource.Health.Requests.Count)
 
 	resp, cleanup = client.Get("/v2/bridge_types/nosuchbridge")
 	t.Cleanup(cleanup)
@@ -0,0 +1,43 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// EVMHeadsController shows the heads tracked for EVM chains.
+type EVMHeadsController struct {
+	App chainlink.Application
+}
+
+// Index lists the longest chain tracked by the head tracker of an EVM chain,
+// from the highest head down to the oldest head kept in memory.
+func (hc *EVMHeadsController) Index(c *gin.Context, size, page, offset int) {
+	id := utils.Big{}
+	if err := id.UnmarshalText([]byte(c.Param("ID"))); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	chain, err := hc.App.GetChains().EVM.Get(id.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+
+	var resources []presenters.EVMHeadResource
+	var count int
+	for head := chain.HeadTracker().LatestChain(); head != nil; head = head.Parent {
+		if count >= offset && count < offset+size {
+			resources = append(resources, presenters.NewEVMHeadResource(*head))
+		}
+		count++
+	}
+
+	paginatedResponse(c, "head", size, page, resources, count, nil)
+}
@@ -0,0 +1,73 @@
+package web_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/headtracker"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func Test_EVMHeadsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+
+	orm := headtracker.NewORM(app.GetSqlxDB(), logger.TestLogger(t), app.GetConfig(), cltest.FixtureChainID)
+	var heads []evmtypes.Head
+	var parentHash = utils.NewHash()
+	for i := 0; i < 3; i++ {
+		h := evmtypes.NewHead(big.NewInt(int64(i)), utils.NewHash(), parentHash, 0, utils.NewBig(&cltest.FixtureChainID))
+		require.NoError(t, orm.IdempotentInsertHead(context.Background(), &h))
+		heads = append(heads, h)
+		parentHash = h.Hash
+	}
+
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	t.Run("lists the tracked chain from the highest head", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/chains/evm/0/heads?size=2")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body := cltest.ParseResponseBody(t, resp)
+		metaCount, err := cltest.ParseJSONAPIResponseMetaCount(body)
+		require.NoError(t, err)
+		assert.Equal(t, 3, metaCount)
+
+		var links jsonapi.Links
+		var resources []presenters.EVMHeadResource
+		require.NoError(t, web.ParsePaginatedResponse(body, &resources, &links))
+		require.Len(t, resources, 2)
+		assert.NotEmpty(t, links["next"].Href)
+
+		assert.Equal(t, heads[2].Hash.Hex(), resources[0].ID)
+		assert.Equal(t, int64(2), resources[0].Number)
+		assert.Equal(t, resources[1].ID, resources[0].ParentHash.Hex())
+		assert.Equal(t, heads[1].Hash.Hex(), resources[1].ID)
+	})
+
+	t.Run("invalid chain ID", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/chains/evm/invalid/heads")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/chains/evm/4242/heads")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -45,6 +45,14 @@ func paginatedResponse(
 	}
 }
 
+func cursorPaginatedResponse(c *gin.Context, name string, size int, nextCursor string, resource interface{}) {
+	if buffer, err := NewCursorPaginatedResponse(*c.Request.URL, size, nextCursor, resource); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to marshal %s document: %+v", name, err))
+	} else {
+		c.Data(http.StatusOK, MediaType, buffer)
+	}
+}
+
 func paginatedRequest(action func(*gin.Context, int, int, int)) func(*gin.Context) {
 	return func(c *gin.Context) {
 		size, page, offset, err := ParsePaginatedRequest(c.Query("size"), c.Query("page"))
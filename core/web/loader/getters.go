@@ -8,11 +8,14 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
@@ -193,3 +196,20 @@ func GetEthTxAttemptsByEthTxID(ctx context.Context, id string) ([]bulletprooftxm
 
 	return attempts, nil
 }
+
+// GetLatestHeadByChainID fetches the highest head seen by the head tracker of
+// a chain, or nil if the chain is not running. Heads are kept in memory by the
+// head tracker, so it is not batched.
+func GetLatestHeadByChainID(ctx context.Context, id utils.Big) *types.Head {
+	chain, err := For(ctx).app.GetChains().EVM.Get(id.ToInt())
+	if err != nil {
+		return nil
+	}
+	return chain.HeadTracker().LatestChain()
+}
+
+// GetBridgeHealth fetches the health of a bridge. Health is kept in memory by
+// the bridge health monitor, so it is not batched.
+func GetBridgeHealth(ctx context.Context, name bridges.TaskType) bridgehealth.Status {
+	return For(ctx).app.GetBridgeHealthMonitor().Status(name)
+}
@@ -2,10 +2,14 @@ package web
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
@@ -27,9 +31,33 @@ type PipelineRunsController struct {
 // Index returns all pipeline runs for a job.
 // Example:
 // "GET <application>/jobs/:ID/runs"
+//
+// Runs can be filtered with the query params status, jobID, createdAfter,
+// createdBefore, failedTaskType, errorContains and outputContains, e.g.
+// "GET <application>/pipeline/runs?status=errored&failedTaskType=http&createdAfter=2022-01-01T00:00:00Z".
+// Filtered results are paginated with the cursor param instead of page,
+// using the cursor returned in the next link.
 func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int) {
 	id := c.Param("ID")
 
+	filter, err := parsePipelineRunsFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if !filter.IsEmpty() || c.Query("cursor") != "" {
+		if id != "" {
+			jobSpec := job.Job{}
+			if err = jobSpec.SetID(id); err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, err)
+				return
+			}
+			filter.JobIDs = []int32{jobSpec.ID}
+		}
+		prc.search(c, filter, size)
+		return
+	}
+
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
 	if c.Query("size") == "" {
 		size = 1000
@@ -37,7 +65,6 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 
 	var pipelineRuns []pipeline.Run
 	var count int
-	var err error
 
 	if id == "" {
 		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(nil, offset, size)
@@ -61,6 +88,76 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, res, count, err)
 }
 
+func (prc *PipelineRunsController) search(c *gin.Context, filter job.PipelineRunsFilter, size int) {
+	var after *job.PipelineRunsCursor
+	if cursor := c.Query("cursor"); cursor != "" {
+		parsed, err := job.ParsePipelineRunsCursor(cursor)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		after = &parsed
+	}
+	if err := filter.Validate(); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRuns, next, err := prc.App.JobORM().SearchPipelineRuns(filter, after, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var nextCursor string
+	if next != nil {
+		nextCursor = next.String()
+	}
+	res := presenters.NewPipelineRunResources(pipelineRuns, prc.App.GetLogger())
+	cursorPaginatedResponse(c, "pipelineRun", size, nextCursor, res)
+}
+
+func parsePipelineRunsFilter(c *gin.Context) (filter job.PipelineRunsFilter, err error) {
+	for _, status := range splitQueryList(c.Query("status")) {
+		filter.States = append(filter.States, pipeline.RunStatus(strings.ToLower(status)))
+	}
+	for _, idStr := range splitQueryList(c.Query("jobID")) {
+		jobSpec := job.Job{}
+		if err = jobSpec.SetID(idStr); err != nil {
+			return filter, errors.Errorf("invalid jobID %q", idStr)
+		}
+		filter.JobIDs = append(filter.JobIDs, jobSpec.ID)
+	}
+	if s := c.Query("createdAfter"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid createdAfter")
+		}
+		filter.CreatedAfter = &t
+	}
+	if s := c.Query("createdBefore"); s != "" {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return filter, errors.Wrap(err, "invalid createdBefore")
+		}
+		filter.CreatedBefore = &t
+	}
+	filter.FailedTaskType = pipeline.TaskType(c.Query("failedTaskType"))
+	filter.ErrorContains = c.Query("errorContains")
+	filter.OutputContains = c.Query("outputContains")
+	return filter, nil
+}
+
+// splitQueryList splits a comma separated query param value.
+func splitQueryList(s string) (items []string) {
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -86,14 +183,17 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 // Example:
 // "POST <application>/jobs/:ID/runs"
 func (prc *PipelineRunsController) Create(c *gin.Context) {
-	respondWithPipelineRun := func(jobRunID int64) {
+	respondWithPipelineRunStatus := func(jobRunID int64, status int) {
 		pipelineRun, err := prc.App.PipelineORM().FindRun(jobRunID)
 		if err != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err)
 			return
 		}
 		res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
-		jsonAPIResponse(c, res, "pipelineRun")
+		jsonAPIResponseWithStatus(c, res, "pipelineRun", status)
+	}
+	respondWithPipelineRun := func(jobRunID int64) {
+		respondWithPipelineRunStatus(jobRunID, http.StatusOK)
 	}
 
 	bodyBytes, err := ioutil.ReadAll(c.Request.Body)
@@ -120,6 +220,18 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.Is(err3, webhook.ErrInvalidInput) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, err3)
+				return
+			} else if errors.Is(err3, webhook.ErrSyncTimeout) {
+				// The run carries on, the caller can poll it by its ID, if it
+				// has been saved yet
+				if jobRunID == 0 {
+					jsonAPIError(c, http.StatusAccepted, err3)
+					return
+				}
+				respondWithPipelineRunStatus(jobRunID, http.StatusAccepted)
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -151,6 +263,47 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// Rerun executes the pipeline of a finished run again with the same inputs.
+// Example:
+// "POST <application>/pipeline/runs/:runID/rerun"
+func (prc *PipelineRunsController) Rerun(c *gin.Context) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if !pipelineRun.State.Finished() {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("only finished runs can be re-run"))
+		return
+	}
+
+	runID, err := prc.App.RerunPipelineRunV2(c.Request.Context(), pipelineRun.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(runID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
@@ -174,10 +327,97 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 		return
 	}
 
-	if err := prc.App.ResumeJobV2(context.Background(), taskID, result); err != nil {
+	if err := prc.App.ResumeJobV2(context.Background(), taskID, result); errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no pending task run found, it may have already been resumed or timed out"))
+		return
+	} else if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
 	c.Status(http.StatusOK)
 }
+
+// ResumeSuspended finishes the pending tasks of a suspended run with the
+// given value or error, and resumes the run. The run is resumed in the
+// background, so the response is the run at the time of the request, which
+// is usually still running.
+// Example:
+// "POST <application>/pipeline/runs/:runID/resume"
+func (prc *PipelineRunsController) ResumeSuspended(c *gin.Context) {
+	rr := pipeline.ResumeRequest{}
+	if err := errors.Wrap(json.NewDecoder(c.Request.Body).Decode(&rr), "failed to unmarshal JSON body"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	result, err := rr.ToResult()
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	prc.finishSuspended(c, result)
+}
+
+// FailSuspended fails the pending tasks of a suspended run, with the error
+// given in the optional body, and resumes the run. As with ResumeSuspended,
+// the response is the run at the time of the request.
+// Example:
+// "POST <application>/pipeline/runs/:runID/fail"
+func (prc *PipelineRunsController) FailSuspended(c *gin.Context) {
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(c.Request.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to unmarshal JSON body"))
+		return
+	}
+	if body.Error == "" {
+		body.Error = "manually failed"
+	}
+	prc.finishSuspended(c, pipeline.Result{Error: errors.New(body.Error)})
+}
+
+func (prc *PipelineRunsController) finishSuspended(c *gin.Context, result pipeline.Result) {
+	pipelineRun := pipeline.Run{}
+	err := pipelineRun.SetID(c.Param("runID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("pipeline run not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if pipelineRun.State != pipeline.RunStatusSuspended {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("only suspended runs can be resumed or failed"))
+		return
+	}
+
+	for _, tr := range pipelineRun.PipelineTaskRuns {
+		if !tr.IsPending() {
+			continue
+		}
+		// The task may have been resumed or timed out in the meantime
+		if err = prc.App.ResumeJobV2(c.Request.Context(), tr.ID, result); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	// The run carries on in the background, its final state can be polled
+	// with Show
+	pipelineRun, err = prc.App.PipelineORM().FindRun(pipelineRun.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
+	jsonAPIResponse(c, res, "pipelineRun")
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pelletier/go-toml"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
@@ -20,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/web"
@@ -85,6 +87,52 @@ func TestPipelineRunsController_CreateWithBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_CreateWithInputSchema(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(10 * time.Millisecond)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+	require.NoError(t, app.Start())
+
+	jb, err := webhook.ValidatedWebhookSpec(testspecs.WebhookSpecWithInputSchema, app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(context.Background(), &jb))
+
+	// Give the job.Spawner ample time to discover the job and start its service
+	time.Sleep(3 * time.Second)
+
+	client := app.NewHTTPClient()
+
+	t.Run("rejects a body that does not match the schema", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+jb.ExternalJobID.String()+"/runs", strings.NewReader(`{"result":"foo"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+		runs, err := app.PipelineORM().GetAllRuns()
+		require.NoError(t, err)
+		require.Len(t, runs, 0)
+	})
+
+	t.Run("responds with the outputs of the run", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+jb.ExternalJobID.String()+"/runs", strings.NewReader(`{"result":1.5}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunResource
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+		require.NotNil(t, parsedResponse.FinishedAt)
+		require.Len(t, parsedResponse.Outputs, 1)
+		require.Equal(t, "150", *parsedResponse.Outputs[0])
+	})
+}
+
 func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	t.Parallel()
 
@@ -215,6 +263,63 @@ func TestPipelineRunsController_Index_Pagination(t *testing.T) {
 	require.Len(t, parsedResponse[0].TaskRuns, 8)
 }
 
+func TestPipelineRunsController_Index_Filters(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	getRuns := func(t *testing.T, path string) ([]presenters.PipelineRunResource, jsonapi.Links) {
+		response, cleanup := client.Get(path)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse []presenters.PipelineRunResource
+		var links jsonapi.Links
+		err := web.ParsePaginatedResponse(cltest.ParseResponseBody(t, response), &parsedResponse, &links)
+		require.NoError(t, err)
+		return parsedResponse, links
+	}
+
+	t.Run("paginates matching runs with a cursor", func(t *testing.T) {
+		runs, links := getRuns(t, fmt.Sprintf("/v2/pipeline/runs?jobID=%d&failedTaskType=fail&errorContains=UH%%20OH&status=completed,errored&size=1", jobID))
+		require.Len(t, runs, 1)
+		assert.Equal(t, strconv.Itoa(int(runIDs[1])), runs[0].ID)
+		require.NotEmpty(t, links["next"].Href)
+
+		runs, links = getRuns(t, links["next"].Href)
+		require.Len(t, runs, 1)
+		assert.Equal(t, strconv.Itoa(int(runIDs[0])), runs[0].ID)
+		assert.Empty(t, links["next"])
+	})
+
+	t.Run("filters runs of a job", func(t *testing.T) {
+		runs, _ := getRuns(t, fmt.Sprintf("/v2/jobs/%d/runs?status=errored", jobID))
+		assert.Empty(t, runs)
+
+		runs, _ = getRuns(t, fmt.Sprintf("/v2/jobs/%d/runs?outputContains=3", jobID))
+		assert.Len(t, runs, 2)
+	})
+
+	t.Run("no matches", func(t *testing.T) {
+		runs, _ := getRuns(t, "/v2/pipeline/runs?failedTaskType=http")
+		assert.Empty(t, runs)
+
+		runs, _ = getRuns(t, "/v2/pipeline/runs?errorContains=100%25")
+		assert.Empty(t, runs)
+	})
+
+	t.Run("invalid filters", func(t *testing.T) {
+		for _, path := range []string{
+			"/v2/pipeline/runs?status=bogus",
+			"/v2/pipeline/runs?createdAfter=yesterday",
+			"/v2/pipeline/runs?jobID=abc",
+			"/v2/pipeline/runs?cursor=!!!",
+		} {
+			response, cleanup := client.Get(path)
+			cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+			cleanup()
+		}
+	})
+}
+
 func TestPipelineRunsController_Show_HappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -245,6 +350,166 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Rerun_HappyPath(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post("/v2/pipeline/runs/"+fmt.Sprintf("%v", runIDs[0])+"/rerun", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedResponse presenters.PipelineRunResource
+	responseBytes := cltest.ParseResponseBody(t, response)
+	assert.Contains(t, string(responseBytes), `"outputs":["3"],"errors":[null],"allErrors":["uh oh"],"fatalErrors":[null]`)
+	err := web.ParseJSONAPIResponse(responseBytes, &parsedResponse)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, strconv.Itoa(int(runIDs[0])), parsedResponse.ID)
+	require.NotNil(t, parsedResponse.RerunOf)
+	assert.Equal(t, strconv.Itoa(int(runIDs[0])), *parsedResponse.RerunOf)
+	require.Len(t, parsedResponse.TaskRuns, 8)
+
+	// The new run is listed with the job's runs
+	response, cleanup = client.Get("/v2/jobs/" + fmt.Sprintf("%v", jobID) + "/runs")
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var parsedRuns []presenters.PipelineRunResource
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedRuns)
+	require.NoError(t, err)
+	require.Len(t, parsedRuns, 3)
+	assert.Equal(t, parsedResponse.ID, parsedRuns[0].ID)
+
+	// It ran with the pipeline spec of the original run
+	for _, run := range parsedRuns {
+		if run.ID == strconv.Itoa(int(runIDs[0])) {
+			assert.Equal(t, run.PipelineSpec.ID, parsedResponse.PipelineSpec.ID)
+			assert.Equal(t, run.PipelineSpec.DotDAGSource, parsedResponse.PipelineSpec.DotDAGSource)
+		}
+	}
+}
+
+func TestPipelineRunsController_Rerun_NotFound(t *testing.T) {
+	t.Parallel()
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	response, cleanup := client.Post("/v2/pipeline/runs/999999/rerun", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestPipelineRunsController_ResumeAndFailSuspended_NotSuspended(t *testing.T) {
+	client, _, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post("/v2/pipeline/runs/"+fmt.Sprintf("%v", runIDs[0])+"/resume", strings.NewReader(`{"value": 1}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/"+fmt.Sprintf("%v", runIDs[0])+"/fail", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/999999/fail", nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post("/v2/pipeline/runs/"+fmt.Sprintf("%v", runIDs[0])+"/resume", strings.NewReader(`{}`))
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+}
+
+func TestPipelineRunsController_ResumeAndFailSuspended(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+	require.NoError(t, app.Start())
+
+	// The bridge suspends every run
+	bridgeServer := cltest.NewHTTPMockServer(t, http.StatusOK, "POST", `{"pending": true}`)
+	_, bridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{URL: bridgeServer.URL}, app.GetConfig())
+
+	jb, err := webhook.ValidatedWebhookSpec(fmt.Sprintf(`
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    ds1          [type=bridge async=true name="%s"];
+    ds1_parse    [type=jsonparse path="data,result"];
+    ds1_multiply [type=multiply times=100];
+
+    ds1 -> ds1_parse -> ds1_multiply;
+"""
+`, bridge.Name.String()), app.GetExternalInitiatorManager())
+	require.NoError(t, err)
+	require.NoError(t, app.AddJobV2(context.Background(), &jb))
+	cltest.AwaitJobActive(t, app.JobSpawner(), jb.ID, 3*time.Second)
+
+	client := app.NewHTTPClient()
+
+	startSuspendedRun := func(t *testing.T) int64 {
+		response, cleanup := client.Post("/v2/jobs/"+jb.ExternalJobID.String()+"/runs", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse))
+		runID, err := strconv.ParseInt(parsedResponse.ID, 10, 64)
+		require.NoError(t, err)
+
+		run, err := app.PipelineORM().FindRun(runID)
+		require.NoError(t, err)
+		require.Equal(t, pipeline.RunStatusSuspended, run.State)
+		return runID
+	}
+
+	awaitRunFinished := func(t *testing.T, runID int64) pipeline.Run {
+		var run pipeline.Run
+		require.Eventually(t, func() bool {
+			var err error
+			run, err = app.PipelineORM().FindRun(runID)
+			require.NoError(t, err)
+			return run.FinishedAt.Valid
+		}, 5*time.Second, 100*time.Millisecond)
+		return run
+	}
+
+	t.Run("resume", func(t *testing.T) {
+		runID := startSuspendedRun(t)
+
+		response, cleanup := client.Post(fmt.Sprintf("/v2/pipeline/runs/%v/resume", runID), strings.NewReader(`{"value": {"data": {"result": "1.5"}}}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		run := awaitRunFinished(t, runID)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.Equal(t, []interface{}{"150"}, run.Outputs.Val)
+
+		// The run is no longer suspended
+		response, cleanup = client.Post(fmt.Sprintf("/v2/pipeline/runs/%v/resume", runID), strings.NewReader(`{"value": 1}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		runID := startSuspendedRun(t)
+
+		response, cleanup := client.Post(fmt.Sprintf("/v2/pipeline/runs/%v/fail", runID), strings.NewReader(`{"error": "adapter down"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		run := awaitRunFinished(t, runID)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		ds1 := run.ByDotID("ds1")
+		require.NotNil(t, ds1)
+		assert.Equal(t, "adapter down", ds1.Error.String)
+		assert.Equal(t, []interface{}{nil}, run.Outputs.Val)
+	})
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
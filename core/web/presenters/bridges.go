@@ -5,6 +5,9 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -14,10 +17,64 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken           string                       `json:"incomingToken,omitempty"`
+	OutgoingToken           string                       `json:"outgoingToken"`
+	MinimumContractPayment  *assets.Link                 `json:"minimumContractPayment"`
+	CacheTTL                models.Interval              `json:"cacheTTL"`
+	StaleIfError            models.Interval              `json:"staleIfError"`
+	Timeout                 models.Interval              `json:"timeout"`
+	Retries                 uint32                       `json:"retries"`
+	CircuitBreakerThreshold uint32                       `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  models.Interval              `json:"circuitBreakerCooldown"`
+	CircuitBreaker          BridgeCircuitBreakerResource `json:"circuitBreaker"`
+	RequestSigning          bridges.RequestSigning       `json:"requestSigning"`
+	ResponsePublicKey       string                       `json:"responsePublicKey"`
+	// Health is only provided when showing a single Bridge
+	Health    *BridgeHealthResource `json:"health,omitempty"`
+	CreatedAt time.Time             `json:"createdAt"`
+}
+
+// BridgeCircuitBreakerResource represents the state of the circuit breaker
+// of a bridge in this node.
+type BridgeCircuitBreakerResource struct {
+	State               pipeline.BridgeCircuitState `json:"state"`
+	ConsecutiveFailures uint32                      `json:"consecutiveFailures"`
+	OpenedAt            *time.Time                  `json:"openedAt"`
+}
+
+// BridgeHealthResource represents the health of a bridge, as seen by its
+// health probes and by the bridge tasks run by this node.
+type BridgeHealthResource struct {
+	State                    bridgehealth.State         `json:"state"`
+	LastProbedAt             *time.Time                 `json:"lastProbedAt"`
+	LastProbeError           string                     `json:"lastProbeError,omitempty"`
+	ConsecutiveProbeFailures uint32                     `json:"consecutiveProbeFailures"`
+	Requests                 BridgeRequestStatsResource `json:"requests"`
+}
+
+// BridgeRequestStatsResource represents stats of the most recent requests
+// made to a bridge by bridge tasks.
+type BridgeRequestStatsResource struct {
+	Count       int             `json:"count"`
+	SuccessRate float64         `json:"successRate"`
+	LatencyP50  models.Interval `json:"latencyP50"`
+	LatencyP99  models.Interval `json:"latencyP99"`
+}
+
+// NewBridgeHealthResource constructs a new BridgeHealthResource
+func NewBridgeHealthResource(status bridgehealth.Status) *BridgeHealthResource {
+	return &BridgeHealthResource{
+		State:                    status.State,
+		LastProbedAt:             status.LastProbedAt,
+		LastProbeError:           status.LastProbeError,
+		ConsecutiveProbeFailures: status.ConsecutiveProbeFailures,
+		Requests: BridgeRequestStatsResource{
+			Count:       status.Requests.Requests,
+			SuccessRate: status.Requests.SuccessRate,
+			LatencyP50:  models.Interval(status.Requests.LatencyP50),
+			LatencyP99:  models.Interval(status.Requests.LatencyP99),
+		},
+	}
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -29,12 +86,21 @@ func (r BridgeResource) GetName() string {
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
-		JAID:                   NewJAID(b.Name.String()),
-		Name:                   b.Name.String(),
-		URL:                    b.URL.String(),
-		Confirmations:          b.Confirmations,
-		OutgoingToken:          b.OutgoingToken,
-		MinimumContractPayment: b.MinimumContractPayment,
-		CreatedAt:              b.CreatedAt,
+		JAID:                    NewJAID(b.Name.String()),
+		Name:                    b.Name.String(),
+		URL:                     b.URL.String(),
+		Confirmations:           b.Confirmations,
+		OutgoingToken:           b.OutgoingToken,
+		MinimumContractPayment:  b.MinimumContractPayment,
+		CacheTTL:                b.CacheTTL,
+		StaleIfError:            b.StaleIfError,
+		Timeout:                 b.Timeout,
+		Retries:                 b.Retries,
+		CircuitBreakerThreshold: b.CircuitBreakerThreshold,
+		CircuitBreakerCooldown:  b.CircuitBreakerCooldown,
+		CircuitBreaker:          BridgeCircuitBreakerResource(pipeline.GetBridgeCircuitBreakerStatus(b.Name.String())),
+		RequestSigning:          b.RequestSigning,
+		ResponsePublicKey:       b.ResponsePublicKey,
+		CreatedAt:               b.CreatedAt,
 	}
 }
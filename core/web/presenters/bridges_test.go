@@ -8,6 +8,8 @@ import (
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +28,7 @@ func TestBridgeResource(t *testing.T) {
 		Confirmations:          1,
 		OutgoingToken:          "vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 		MinimumContractPayment: assets.NewLinkFromJuels(1),
+		CacheTTL:               models.Interval(30 * time.Second),
 		CreatedAt:              timestamp,
 	}
 
@@ -45,6 +48,15 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheTTL":"30s",
+			"staleIfError":"0s",
+			"timeout":"0s",
+			"retries":0,
+			"circuitBreakerThreshold":0,
+			"circuitBreakerCooldown":"0s",
+			"circuitBreaker":{"state":"closed","consecutiveFailures":0,"openedAt":null},
+			"requestSigning":"",
+			"responsePublicKey":"",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -70,6 +82,79 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"cacheTTL":"30s",
+			"staleIfError":"0s",
+			"timeout":"0s",
+			"retries":0,
+			"circuitBreakerThreshold":0,
+			"circuitBreakerCooldown":"0s",
+			"circuitBreaker":{"state":"closed","consecutiveFailures":0,"openedAt":null},
+			"requestSigning":"",
+			"responsePublicKey":"",
+			"createdAt":"2000-01-01T00:00:00Z"
+		}
+	}
+}
+`
+
+	assert.JSONEq(t, expected, string(b))
+}
+
+func TestBridgeResource_Health(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	url, err := url.Parse("https://bridge.example.com/api")
+	require.NoError(t, err)
+
+	r := NewBridgeResource(bridges.BridgeType{
+		Name:      "test",
+		URL:       models.WebURL(*url),
+		CreatedAt: timestamp,
+	})
+	r.Health = NewBridgeHealthResource(bridgehealth.Status{
+		State:                    bridgehealth.StateDown,
+		LastProbedAt:             &timestamp,
+		LastProbeError:           "probe got status code 503",
+		ConsecutiveProbeFailures: 2,
+		Requests: pipeline.BridgeRequestStats{
+			Requests:    4,
+			SuccessRate: 0.75,
+			LatencyP50:  250 * time.Millisecond,
+			LatencyP99:  3 * time.Second,
+		},
+	})
+
+	b, err := jsonapi.Marshal(r)
+	require.NoError(t, err)
+
+	expected := `
+{
+	"data": {
+		"type":"bridges",
+		"id":"test",
+		"attributes":{
+			"name":"test",
+			"url":"https://bridge.example.com/api",
+			"confirmations":0,
+			"outgoingToken":"",
+			"minimumContractPayment":null,
+			"cacheTTL":"0s",
+			"staleIfError":"0s",
+			"timeout":"0s",
+			"retries":0,
+			"circuitBreakerThreshold":0,
+			"circuitBreakerCooldown":"0s",
+			"circuitBreaker":{"state":"closed","consecutiveFailures":0,"openedAt":null},
+			"requestSigning":"",
+			"responsePublicKey":"",
+			"health":{
+				"state":"down",
+				"lastProbedAt":"2000-01-01T00:00:00Z",
+				"lastProbeError":"probe got status code 503",
+				"consecutiveProbeFailures":2,
+				"requests":{"count":4,"successRate":0.75,"latencyP50":"250ms","latencyP99":"3s"}
+			},
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -3,6 +3,7 @@ package presenters
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"gopkg.in/guregu/null.v4"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -62,3 +63,33 @@ func NewEVMNodeResource(node evmtypes.Node) EVMNodeResource {
 		UpdatedAt:  node.UpdatedAt,
 	}
 }
+
+// EVMHeadResource is an EVM head JSONAPI resource, identified by its hash.
+// ParentHash links it to the ID of its parent.
+type EVMHeadResource struct {
+	JAID
+	Number        int64       `json:"number"`
+	ParentHash    common.Hash `json:"parentHash"`
+	EVMChainID    *utils.Big  `json:"evmChainID"`
+	Timestamp     time.Time   `json:"timestamp"`
+	BaseFeePerGas *utils.Big  `json:"baseFeePerGas"`
+	IsFinalized   bool        `json:"isFinalized"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r EVMHeadResource) GetName() string {
+	return "evm_head"
+}
+
+// NewEVMHeadResource returns a new EVMHeadResource for head.
+func NewEVMHeadResource(head evmtypes.Head) EVMHeadResource {
+	return EVMHeadResource{
+		JAID:          NewJAID(head.Hash.Hex()),
+		Number:        head.Number,
+		ParentHash:    head.ParentHash,
+		EVMChainID:    head.EVMChainID,
+		Timestamp:     head.Timestamp,
+		BaseFeePerGas: head.BaseFeePerGas,
+		IsFinalized:   head.IsFinalized,
+	}
+}
@@ -37,6 +37,8 @@ const (
 	WebhookJobSpec           JobSpecType = "webhook"
 	BlockhashStoreJobSpec    JobSpecType = "blockhashstore"
 	BootstrapJobSpec         JobSpecType = "bootstrap"
+	EVMLogJobSpec            JobSpecType = "evmlog"
+	BlockTriggerJobSpec      JobSpecType = "blocktrigger"
 )
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
@@ -251,31 +253,45 @@ func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	InputSchema null.String     `json:"inputSchema"`
+	Sync        bool            `json:"sync"`
+	SyncTimeout models.Duration `json:"syncTimeout"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		InputSchema: spec.InputSchema,
+		Sync:        spec.Sync,
+		SyncTimeout: models.MustMakeDuration(spec.SyncTimeout),
+		CreatedAt:   spec.CreatedAt,
+		UpdatedAt:   spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule      string                    `json:"schedule" tom:"schedule"`
+	Jitter            models.Duration           `json:"jitter"`
+	MaxConcurrentRuns uint32                    `json:"maxConcurrentRuns"`
+	ConcurrencyPolicy job.CronConcurrencyPolicy `json:"concurrencyPolicy"`
+	CatchUp           bool                      `json:"catchUp"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:      spec.CronSchedule,
+		Jitter:            models.MustMakeDuration(spec.Jitter),
+		MaxConcurrentRuns: spec.MaxConcurrentRuns,
+		ConcurrencyPolicy: spec.ConcurrencyPolicy,
+		CatchUp:           spec.CatchUp,
+		CreatedAt:         spec.CreatedAt,
+		UpdatedAt:         spec.UpdatedAt,
 	}
 }
 
@@ -360,6 +376,48 @@ func NewBootstrapSpec(spec *job.BootstrapSpec) *BootstrapSpec {
 	}
 }
 
+// EVMLogSpec defines the spec details of an EVMLog Job
+type EVMLogSpec struct {
+	ContractAddress          ethkey.EIP55Address `json:"contractAddress"`
+	EventABI                 string              `json:"eventABI"`
+	TopicFilters             job.TopicFilters    `json:"topicFilters"`
+	MinIncomingConfirmations clnull.Uint32       `json:"minIncomingConfirmations"`
+	EVMChainID               *utils.Big          `json:"evmChainID"`
+	CreatedAt                time.Time           `json:"createdAt"`
+	UpdatedAt                time.Time           `json:"updatedAt"`
+}
+
+// NewEVMLogSpec initializes a new EVMLogSpec from a job.EVMLogSpec
+func NewEVMLogSpec(spec *job.EVMLogSpec) *EVMLogSpec {
+	return &EVMLogSpec{
+		ContractAddress:          spec.ContractAddress,
+		EventABI:                 spec.EventABI,
+		TopicFilters:             spec.TopicFilters,
+		MinIncomingConfirmations: spec.MinIncomingConfirmations,
+		EVMChainID:               spec.EVMChainID,
+		CreatedAt:                spec.CreatedAt,
+		UpdatedAt:                spec.UpdatedAt,
+	}
+}
+
+// BlockTriggerSpec defines the spec details of a BlockTrigger Job
+type BlockTriggerSpec struct {
+	BlockInterval uint32     `json:"blockInterval"`
+	EVMChainID    *utils.Big `json:"evmChainID"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+// NewBlockTriggerSpec initializes a new BlockTriggerSpec from a job.BlockTriggerSpec
+func NewBlockTriggerSpec(spec *job.BlockTriggerSpec) *BlockTriggerSpec {
+	return &BlockTriggerSpec{
+		BlockInterval: spec.BlockInterval,
+		EVMChainID:    spec.EVMChainID,
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
+	}
+}
+
 // JobError represents errors on the job
 type JobError struct {
 	ID          int64     `json:"id"`
@@ -397,6 +455,8 @@ type JobResource struct {
 	WebhookSpec            *WebhookSpec            `json:"webhookSpec"`
 	BlockhashStoreSpec     *BlockhashStoreSpec     `json:"blockhashStoreSpec"`
 	BootstrapSpec          *BootstrapSpec          `json:"bootstrapSpec"`
+	EVMLogSpec             *EVMLogSpec             `json:"evmLogSpec"`
+	BlockTriggerSpec       *BlockTriggerSpec       `json:"blockTriggerSpec"`
 	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
 	Errors                 []JobError              `json:"errors"`
 }
@@ -434,6 +494,10 @@ func NewJobResource(j job.Job) *JobResource {
 		resource.BlockhashStoreSpec = NewBlockhashStoreSpec(j.BlockhashStoreSpec)
 	case job.Bootstrap:
 		resource.BootstrapSpec = NewBootstrapSpec(j.BootstrapSpec)
+	case job.EVMLog:
+		resource.EVMLogSpec = NewEVMLogSpec(j.EVMLogSpec)
+	case job.BlockTrigger:
+		resource.BlockTriggerSpec = NewBlockTriggerSpec(j.BlockTriggerSpec)
 	}
 
 	jes := []JobError{}
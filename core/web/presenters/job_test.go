@@ -14,6 +14,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -105,6 +106,8 @@ func TestJob(t *testing.T) {
 						"webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -177,6 +180,8 @@ func TestJob(t *testing.T) {
 						"webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -257,6 +262,8 @@ func TestJob(t *testing.T) {
 						"webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -316,6 +323,8 @@ func TestJob(t *testing.T) {
                         "vrfSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -358,6 +367,10 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "jitter": "0s",
+                            "maxConcurrentRuns": 0,
+                            "concurrencyPolicy": "",
+                            "catchUp": false,
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z"
                         },
@@ -370,6 +383,8 @@ func TestJob(t *testing.T) {
                         "webhookSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
                         "errors": []
                     }
                 }
@@ -380,8 +395,11 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				WebhookSpec: &job.WebhookSpec{
-					CreatedAt: timestamp,
-					UpdatedAt: timestamp,
+					InputSchema: null.StringFrom(`{"type":"object"}`),
+					Sync:        true,
+					SyncTimeout: 30 * time.Second,
+					CreatedAt:   timestamp,
+					UpdatedAt:   timestamp,
 				},
 				ExternalJobID: uuid.FromStringOrNil("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
 				PipelineSpec: &pipeline.Spec{
@@ -410,6 +428,9 @@ func TestJob(t *testing.T) {
 							"jobID": 0
 						},
 						"webhookSpec": {
+							"inputSchema": "{\"type\":\"object\"}",
+							"sync": true,
+							"syncTimeout": "30s",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z"
 						},
@@ -422,6 +443,8 @@ func TestJob(t *testing.T) {
                         "vrfSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"errors": []
 					}
 				}
@@ -485,6 +508,8 @@ func TestJob(t *testing.T) {
 							"updatedAt": "0001-01-01T00:00:00Z"
 						},
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"jobID": 0,
@@ -545,6 +570,128 @@ func TestJob(t *testing.T) {
 							"relayConfig":{"chainID":1337}, 
 							"updatedAt":"0001-01-01T00:00:00Z"
 						},
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
+						"pipelineSpec": {
+							"id": 1,
+							"jobID": 0,
+							"dotDagSource": ""
+						},
+						"errors": []
+					}
+				}
+			}`,
+		},
+		{
+			name: "evmlog spec",
+			job: job.Job{
+				ID: 1,
+				EVMLogSpec: &job.EVMLogSpec{
+					ContractAddress:          contractAddress,
+					EventABI:                 "Transfer(address indexed from, address indexed to, uint256 value)",
+					TopicFilters:             job.TopicFilters{"to": {"0xa8037A20989AFcBC51798de9762b351D63ff462e"}},
+					MinIncomingConfirmations: clnull.Uint32From(3),
+					EVMChainID:               evmChainID,
+					CreatedAt:                timestamp,
+					UpdatedAt:                timestamp,
+				},
+				PipelineSpec: &pipeline.Spec{
+					ID:           1,
+					DotDagSource: "",
+				},
+				ExternalJobID: uuid.FromStringOrNil("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
+				Type:          job.EVMLog,
+				SchemaVersion: 1,
+				Name:          null.StringFrom("test"),
+			},
+			want: fmt.Sprintf(`
+			{
+				"data": {
+					"type": "jobs",
+					"id": "1",
+					"attributes": {
+						"name": "test",
+						"type": "evmlog",
+						"schemaVersion": 1,
+						"maxTaskDuration": "0s",
+						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"directRequestSpec": null,
+						"fluxMonitorSpec": null,
+						"cronSpec": null,
+						"offChainReportingOracleSpec": null,
+						"offChainReporting2OracleSpec": null,
+						"keeperSpec": null,
+						"vrfSpec": null,
+						"webhookSpec": null,
+						"blockhashStoreSpec": null,
+						"bootstrapSpec": null,
+						"evmLogSpec": {
+							"contractAddress": "%s",
+							"eventABI": "Transfer(address indexed from, address indexed to, uint256 value)",
+							"topicFilters": {"to": ["0xa8037A20989AFcBC51798de9762b351D63ff462e"]},
+							"minIncomingConfirmations": 3,
+							"evmChainID": "42",
+							"createdAt": "2000-01-01T00:00:00Z",
+							"updatedAt": "2000-01-01T00:00:00Z"
+						},
+						"blockTriggerSpec": null,
+						"pipelineSpec": {
+							"id": 1,
+							"jobID": 0,
+							"dotDagSource": ""
+						},
+						"errors": []
+					}
+				}
+			}`, contractAddress),
+		},
+		{
+			name: "block trigger spec",
+			job: job.Job{
+				ID: 1,
+				BlockTriggerSpec: &job.BlockTriggerSpec{
+					BlockInterval: 10,
+					EVMChainID:    evmChainID,
+					CreatedAt:     timestamp,
+					UpdatedAt:     timestamp,
+				},
+				PipelineSpec: &pipeline.Spec{
+					ID:           1,
+					DotDagSource: "",
+				},
+				ExternalJobID: uuid.FromStringOrNil("0eec7e1d-d0d2-476c-a1a8-72dfb6633f46"),
+				Type:          job.BlockTrigger,
+				SchemaVersion: 1,
+				Name:          null.StringFrom("test"),
+			},
+			want: `
+			{
+				"data": {
+					"type": "jobs",
+					"id": "1",
+					"attributes": {
+						"name": "test",
+						"type": "blocktrigger",
+						"schemaVersion": 1,
+						"maxTaskDuration": "0s",
+						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"directRequestSpec": null,
+						"fluxMonitorSpec": null,
+						"cronSpec": null,
+						"offChainReportingOracleSpec": null,
+						"offChainReporting2OracleSpec": null,
+						"keeperSpec": null,
+						"vrfSpec": null,
+						"webhookSpec": null,
+						"blockhashStoreSpec": null,
+						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": {
+							"blockInterval": 10,
+							"evmChainID": "42",
+							"createdAt": "2000-01-01T00:00:00Z",
+							"updatedAt": "2000-01-01T00:00:00Z"
+						},
 						"pipelineSpec": {
 							"id": 1,
 							"jobID": 0,
@@ -618,6 +765,8 @@ func TestJob(t *testing.T) {
 						"vrfSpec": null,
 						"blockhashStoreSpec": null,
 						"bootstrapSpec": null,
+						"evmLogSpec": null,
+						"blockTriggerSpec": null,
 						"errors": [{
 							"id": 200,
 							"description": "some error",
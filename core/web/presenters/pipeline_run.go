@@ -1,6 +1,7 @@
 package presenters
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -21,6 +22,7 @@ type PipelineRunResource struct {
 	CreatedAt    time.Time                 `json:"createdAt"`
 	FinishedAt   time.Time                 `json:"finishedAt"`
 	PipelineSpec PipelineSpec              `json:"pipelineSpec"`
+	RerunOf      *string                   `json:"rerunOf"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -42,6 +44,12 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 
 	fatalErrors := pr.StringFatalErrors()
 
+	var rerunOf *string
+	if pr.RerunOf.Valid {
+		id := strconv.FormatInt(pr.RerunOf.Int64, 10)
+		rerunOf = &id
+	}
+
 	return PipelineRunResource{
 		JAID:         NewJAIDInt64(pr.ID),
 		Outputs:      outputs,
@@ -53,6 +61,7 @@ func NewPipelineRunResource(pr pipeline.Run, lggr logger.Logger) PipelineRunReso
 		CreatedAt:    pr.CreatedAt,
 		FinishedAt:   pr.FinishedAt.ValueOrZero(),
 		PipelineSpec: NewPipelineSpec(&pr.PipelineSpec),
+		RerunOf:      rerunOf,
 	}
 }
 
@@ -64,6 +73,9 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Skipped    bool              `json:"skipped"`
+	Details    *string           `json:"details"`
+	ExpiresAt  *time.Time        `json:"expiresAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -78,10 +90,20 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		outputStr := string(outputBytes)
 		output = &outputStr
 	}
+	var details *string
+	if tr.Details.Valid {
+		detailsBytes, _ := tr.Details.MarshalJSON()
+		detailsStr := string(detailsBytes)
+		details = &detailsStr
+	}
 	var error *string
 	if tr.Error.Valid {
 		error = &tr.Error.String
 	}
+	var expiresAt *time.Time
+	if tr.ExpiresAt.Valid {
+		expiresAt = &tr.ExpiresAt.Time
+	}
 	return PipelineTaskRunResource{
 		Type:       tr.Type,
 		CreatedAt:  tr.CreatedAt,
@@ -89,6 +111,9 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      error,
 		DotID:      tr.GetDotID(),
+		Skipped:    tr.Skipped,
+		Details:    details,
+		ExpiresAt:  expiresAt,
 	}
 }
 
@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+)
+
+// SecretResource represents a node secret JSONAPI resource. It never includes
+// the secret's value.
+type SecretResource struct {
+	JAID
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (SecretResource) GetName() string {
+	return "secrets"
+}
+
+// NewSecretResource constructs a new SecretResource
+func NewSecretResource(secret keystore.Secret) *SecretResource {
+	return &SecretResource{
+		JAID:      NewJAID(secret.Name),
+		Name:      secret.Name,
+		CreatedAt: secret.CreatedAt,
+		UpdatedAt: secret.UpdatedAt,
+	}
+}
+
+// NewSecretResources constructs a list of SecretResources
+func NewSecretResources(secrets []keystore.Secret) []SecretResource {
+	rs := []SecretResource{}
+	for _, secret := range secrets {
+		rs = append(rs, *NewSecretResource(secret))
+	}
+	return rs
+}
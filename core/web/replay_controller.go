@@ -1,12 +1,16 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -17,6 +21,12 @@ type ReplayController struct {
 // ReplayFromBlock causes the node to process blocks again from the given block number
 // Example:
 //  "<application>/v2/replay_from_block/:number"
+//
+// The replay can be limited to the log listeners of a job and/or on a contract
+// with the jobID and contract query params, in which case force=true also
+// re-delivers the logs that were already consumed.
+// Example:
+//  "<application>/v2/replay_from_block/:number?jobID=1&force=true"
 func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	if c.Param("number") == "" {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'number' parameter"))
@@ -46,7 +56,46 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	}
 	chainID := chain.ID()
 
-	if err := bdc.App.ReplayFromBlock(chainID, uint64(blockNumber)); err != nil {
+	opts := log.ReplayListenersOpts{FromBlock: blockNumber}
+	if jobID := c.Query("jobID"); jobID != "" {
+		var jb job.Job
+		if err = jb.SetID(jobID); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		if _, err = bdc.App.JobORM().FindJob(c.Request.Context(), jb.ID); errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		opts.JobID = jb.ID
+	}
+	if contract := c.Query("contract"); contract != "" {
+		if !common.IsHexAddress(contract) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid contract address: %s", contract))
+			return
+		}
+		opts.Contract = common.HexToAddress(contract)
+	}
+	if force := c.Query("force"); force != "" {
+		opts.ForceBroadcast, err = strconv.ParseBool(force)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid 'force' parameter"))
+			return
+		}
+	}
+
+	if opts.JobID != 0 || opts.Contract != (common.Address{}) {
+		err = bdc.App.ReplayListeners(chainID, opts)
+	} else if opts.ForceBroadcast {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("'force' requires a 'jobID' or 'contract' parameter"))
+		return
+	} else {
+		err = bdc.App.ReplayFromBlock(chainID, uint64(blockNumber))
+	}
+	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -1,9 +1,14 @@
 package resolver
 
 import (
+	"context"
+
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
 // BridgeResolver resolves the Bridge type.
@@ -54,11 +59,146 @@ func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
 }
 
+// CacheTTL resolves the bridge's response cache TTL.
+func (r *BridgeResolver) CacheTTL() string {
+	return r.bridge.CacheTTL.Duration().String()
+}
+
+// StaleIfError resolves how long a stale response may be used when the bridge errors.
+func (r *BridgeResolver) StaleIfError() string {
+	return r.bridge.StaleIfError.Duration().String()
+}
+
+// Timeout resolves the bridge's request timeout.
+func (r *BridgeResolver) Timeout() string {
+	return r.bridge.Timeout.Duration().String()
+}
+
+// Retries resolves how many times a failed bridge request is retried.
+func (r *BridgeResolver) Retries() int32 {
+	return int32(r.bridge.Retries)
+}
+
+// CircuitBreakerThreshold resolves the number of consecutive failures that open the bridge's circuit.
+func (r *BridgeResolver) CircuitBreakerThreshold() int32 {
+	return int32(r.bridge.CircuitBreakerThreshold)
+}
+
+// CircuitBreakerCooldown resolves how long the bridge's circuit stays open before a probe.
+func (r *BridgeResolver) CircuitBreakerCooldown() string {
+	return r.bridge.CircuitBreakerCooldown.Duration().String()
+}
+
+// CircuitBreaker resolves the state of the bridge's circuit breaker.
+func (r *BridgeResolver) CircuitBreaker() *BridgeCircuitBreakerResolver {
+	return &BridgeCircuitBreakerResolver{status: pipeline.GetBridgeCircuitBreakerStatus(r.bridge.Name.String())}
+}
+
 // CreatedAt resolves the bridge's created at field.
 func (r *BridgeResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.bridge.CreatedAt}
 }
 
+// RequestSigning resolves how requests to the bridge are signed.
+func (r *BridgeResolver) RequestSigning() string {
+	return string(r.bridge.RequestSigning)
+}
+
+// ResponsePublicKey resolves the public key that must sign the bridge's responses.
+func (r *BridgeResolver) ResponsePublicKey() string {
+	return r.bridge.ResponsePublicKey
+}
+
+// Health resolves the bridge's health.
+func (r *BridgeResolver) Health(ctx context.Context) *BridgeHealthResolver {
+	return &BridgeHealthResolver{status: loader.GetBridgeHealth(ctx, r.bridge.Name)}
+}
+
+// BridgeCircuitBreakerResolver resolves the state of a bridge circuit breaker.
+type BridgeCircuitBreakerResolver struct {
+	status pipeline.BridgeCircuitBreakerStatus
+}
+
+// State resolves the state of the circuit.
+func (r *BridgeCircuitBreakerResolver) State() string {
+	return string(r.status.State)
+}
+
+// ConsecutiveFailures resolves the number of consecutive failed requests.
+func (r *BridgeCircuitBreakerResolver) ConsecutiveFailures() int32 {
+	return int32(r.status.ConsecutiveFailures)
+}
+
+// OpenedAt resolves when the circuit last opened, if it is not closed.
+func (r *BridgeCircuitBreakerResolver) OpenedAt() *graphql.Time {
+	if r.status.OpenedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.status.OpenedAt}
+}
+
+// BridgeHealthResolver resolves the health of a bridge.
+type BridgeHealthResolver struct {
+	status bridgehealth.Status
+}
+
+// State resolves the state of the bridge according to its health probes.
+func (r *BridgeHealthResolver) State() string {
+	return string(r.status.State)
+}
+
+// LastProbedAt resolves when the bridge was last probed.
+func (r *BridgeHealthResolver) LastProbedAt() *graphql.Time {
+	if r.status.LastProbedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *r.status.LastProbedAt}
+}
+
+// LastProbeError resolves the error of the last probe, if it failed.
+func (r *BridgeHealthResolver) LastProbeError() *string {
+	if r.status.LastProbeError == "" {
+		return nil
+	}
+	return &r.status.LastProbeError
+}
+
+// ConsecutiveProbeFailures resolves the number of probes that failed since
+// the last successful one.
+func (r *BridgeHealthResolver) ConsecutiveProbeFailures() int32 {
+	return int32(r.status.ConsecutiveProbeFailures)
+}
+
+// Requests resolves the stats of the most recent requests to the bridge.
+func (r *BridgeHealthResolver) Requests() *BridgeRequestStatsResolver {
+	return &BridgeRequestStatsResolver{stats: r.status.Requests}
+}
+
+// BridgeRequestStatsResolver resolves the stats of requests to a bridge.
+type BridgeRequestStatsResolver struct {
+	stats pipeline.BridgeRequestStats
+}
+
+// Count resolves the number of requests the stats are computed over.
+func (r *BridgeRequestStatsResolver) Count() int32 {
+	return int32(r.stats.Requests)
+}
+
+// SuccessRate resolves the fraction of successful requests.
+func (r *BridgeRequestStatsResolver) SuccessRate() float64 {
+	return r.stats.SuccessRate
+}
+
+// LatencyP50 resolves the median request latency.
+func (r *BridgeRequestStatsResolver) LatencyP50() string {
+	return r.stats.LatencyP50.String()
+}
+
+// LatencyP99 resolves the 99th percentile request latency.
+func (r *BridgeRequestStatsResolver) LatencyP99() string {
+	return r.stats.LatencyP99.String()
+}
+
 // BridgePayloadResolver resolves a single bridge response
 type BridgePayloadResolver struct {
 	bridge bridges.BridgeType
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -12,6 +13,8 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/bridgehealth"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -97,6 +100,29 @@ func Test_Bridge(t *testing.T) {
 						confirmations
 						outgoingToken
 						minimumContractPayment
+						timeout
+						retries
+						circuitBreakerThreshold
+						circuitBreakerCooldown
+						circuitBreaker {
+							state
+							consecutiveFailures
+							openedAt
+						}
+						requestSigning
+						responsePublicKey
+						health {
+							state
+							lastProbedAt
+							lastProbeError
+							consecutiveProbeFailures
+							requests {
+								count
+								successRate
+								latencyP50
+								latencyP99
+							}
+						}
 						createdAt
 					}
 					... on NotFoundError {
@@ -119,13 +145,31 @@ func Test_Bridge(t *testing.T) {
 			before: func(f *gqlTestFramework) {
 				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
 				f.Mocks.bridgeORM.On("FindBridge", name).Return(bridges.BridgeType{
-					Name:                   name,
-					URL:                    models.WebURL(*bridgeURL),
-					Confirmations:          uint32(1),
-					OutgoingToken:          "outgoingToken",
-					MinimumContractPayment: assets.NewLinkFromJuels(1),
-					CreatedAt:              f.Timestamp(),
+					Name:                    name,
+					URL:                     models.WebURL(*bridgeURL),
+					Confirmations:           uint32(1),
+					OutgoingToken:           "outgoingToken",
+					MinimumContractPayment:  assets.NewLinkFromJuels(1),
+					Timeout:                 models.Interval(10 * time.Second),
+					Retries:                 2,
+					CircuitBreakerThreshold: 5,
+					RequestSigning:          bridges.RequestSigningHMAC,
+					CreatedAt:               f.Timestamp(),
 				}, nil)
+				probedAt := f.Timestamp()
+				f.App.On("GetBridgeHealthMonitor").Return(f.Mocks.bridgeHlth)
+				f.Mocks.bridgeHlth.On("Status", name).Return(bridgehealth.Status{
+					State:                    bridgehealth.StateDown,
+					LastProbedAt:             &probedAt,
+					LastProbeError:           "probe got status code 503",
+					ConsecutiveProbeFailures: 3,
+					Requests: pipeline.BridgeRequestStats{
+						Requests:    10,
+						SuccessRate: 0.9,
+						LatencyP50:  100 * time.Millisecond,
+						LatencyP99:  2 * time.Second,
+					},
+				})
 			},
 			query: query,
 			result: `{
@@ -136,6 +180,29 @@ func Test_Bridge(t *testing.T) {
 					"confirmations": 1,
 					"outgoingToken": "outgoingToken",
 					"minimumContractPayment": "1",
+					"timeout": "10s",
+					"retries": 2,
+					"circuitBreakerThreshold": 5,
+					"circuitBreakerCooldown": "0s",
+					"circuitBreaker": {
+						"state": "closed",
+						"consecutiveFailures": 0,
+						"openedAt": null
+					},
+					"requestSigning": "hmac",
+					"responsePublicKey": "",
+					"health": {
+						"state": "down",
+						"lastProbedAt": "2021-01-01T00:00:00Z",
+						"lastProbeError": "probe got status code 503",
+						"consecutiveProbeFailures": 3,
+						"requests": {
+							"count": 10,
+							"successRate": 0.9,
+							"latencyP50": "100ms",
+							"latencyP99": "2s"
+						}
+					},
 					"createdAt": "2021-01-01T00:00:00Z"
 				}
 			}`,
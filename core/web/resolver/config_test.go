@@ -180,6 +180,10 @@ func TestResolver_Config(t *testing.T) {
 		"key":"SHUTDOWN_GRACE_PERIOD",
 		"value":"5s"
 	  },
+      {
+        "key": "EVM_LOG_LISTENER_OVERFLOW_POLICY",
+        "value": "drop-oldest"
+      },
       {
         "key": "EVM_RPC_ENABLED",
         "value": "false"
@@ -208,6 +212,10 @@ func TestResolver_Config(t *testing.T) {
         "key": "FEATURE_EXTERNAL_INITIATORS",
         "value": "true"
       },
+      {
+        "key": "FEATURE_LOG_POLLER",
+        "value": "false"
+      },
       {
         "key": "FEATURE_OFFCHAIN_REPORTING",
         "value": "false"
@@ -232,6 +240,18 @@ func TestResolver_Config(t *testing.T) {
         "key": "JOB_PIPELINE_REAPER_THRESHOLD",
         "value": "24h0m0s"
       },
+      {
+        "key": "JOB_PIPELINE_REAPER_FAILED_THRESHOLD",
+        "value": ""
+      },
+      {
+        "key": "JOB_PIPELINE_REAPER_MAX_RUNS",
+        "value": "0"
+      },
+      {
+        "key": "JOB_PIPELINE_REAPER_EXPORT_DIR",
+        "value": ""
+      },
       {
         "key": "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
         "value": "1"
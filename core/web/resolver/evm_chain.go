@@ -6,6 +6,7 @@ import (
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
@@ -61,6 +62,50 @@ func (r *ChainResolver) Nodes(ctx context.Context) ([]*NodeResolver, error) {
 	return NewNodes(nodes), nil
 }
 
+// LatestHead resolves the highest head seen by the chain's head tracker.
+func (r *ChainResolver) LatestHead(ctx context.Context) *HeadResolver {
+	head := loader.GetLatestHeadByChainID(ctx, r.chain.ID)
+	if head == nil {
+		return nil
+	}
+
+	return NewHead(*head)
+}
+
+// HeadResolver resolves the Head type.
+type HeadResolver struct {
+	head types.Head
+}
+
+func NewHead(head types.Head) *HeadResolver {
+	return &HeadResolver{head: head}
+}
+
+// Hash resolves the head's hash.
+func (r *HeadResolver) Hash() string {
+	return r.head.Hash.Hex()
+}
+
+// Number resolves the head's block number.
+func (r *HeadResolver) Number() string {
+	return stringutils.FromInt64(r.head.Number)
+}
+
+// ParentHash resolves the hash of the head's parent.
+func (r *HeadResolver) ParentHash() string {
+	return r.head.ParentHash.Hex()
+}
+
+// Timestamp resolves the head's timestamp.
+func (r *HeadResolver) Timestamp() graphql.Time {
+	return graphql.Time{Time: r.head.Timestamp}
+}
+
+// IsFinalized resolves whether the head is known to be finalized.
+func (r *HeadResolver) IsFinalized() bool {
+	return r.head.IsFinalized
+}
+
 type ChainPayloadResolver struct {
 	chain types.Chain
 	NotFoundErrorUnionType
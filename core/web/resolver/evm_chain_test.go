@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
+	htmocks "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -271,6 +272,83 @@ func TestResolver_Chain(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_ChainLatestHead(t *testing.T) {
+	var (
+		chainID = *utils.NewBigI(1)
+		query   = `
+			query GetChain {
+				chain(id: "1") {
+					... on Chain {
+						id
+						latestHead {
+							hash
+							number
+							parentHash
+							timestamp
+							isFinalized
+						}
+					}
+				}
+			}
+		`
+	)
+	head := types.NewHead(big.NewInt(42), common.HexToHash("0x1"), common.HexToHash("0x2"), 0, &chainID)
+	head.Timestamp = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				ht := new(htmocks.HeadTracker)
+				ht.Test(t)
+				ht.On("LatestChain").Return(&head)
+				t.Cleanup(func() { ht.AssertExpectations(t) })
+
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+				f.Mocks.evmORM.On("Chain", chainID).Return(types.Chain{ID: chainID}, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(f.Mocks.chain, nil)
+				f.Mocks.chain.On("HeadTracker").Return(ht)
+			},
+			query: query,
+			result: `
+				{
+					"chain": {
+						"id": "1",
+						"latestHead": {
+							"hash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+							"number": "42",
+							"parentHash": "0x0000000000000000000000000000000000000000000000000000000000000002",
+							"timestamp": "2021-01-01T00:00:00Z",
+							"isFinalized": false
+						}
+					}
+				}`,
+		},
+		{
+			name:          "chain not running",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("EVMORM").Return(f.Mocks.evmORM)
+				f.Mocks.evmORM.On("Chain", chainID).Return(types.Chain{ID: chainID}, nil)
+				f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+				f.Mocks.chainSet.On("Get", chainID.ToInt()).Return(nil, errors.New("chain not found"))
+			},
+			query: query,
+			result: `
+				{
+					"chain": {
+						"id": "1",
+						"latestHead": null
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_CreateChain(t *testing.T) {
 	t.Parallel()
 
@@ -64,6 +64,60 @@ func ValidateBridgeTypeUniqueness(bt *bridges.BridgeTypeRequest, orm bridges.ORM
 	return nil
 }
 
+// parseBridgeCacheInput sets the optional cache durations of a bridge request
+func parseBridgeCacheInput(btr *bridges.BridgeTypeRequest, cacheTTL, staleIfError *string) error {
+	if cacheTTL != nil {
+		if err := btr.CacheTTL.UnmarshalText([]byte(*cacheTTL)); err != nil {
+			return errors.Wrap(err, "invalid cacheTTL")
+		}
+	}
+	if staleIfError != nil {
+		if err := btr.StaleIfError.UnmarshalText([]byte(*staleIfError)); err != nil {
+			return errors.Wrap(err, "invalid staleIfError")
+		}
+	}
+	return nil
+}
+
+// parseBridgeResilienceInput sets the optional timeout, retry and circuit
+// breaker settings of a bridge request
+func parseBridgeResilienceInput(btr *bridges.BridgeTypeRequest, timeout *string, retries, circuitBreakerThreshold *int32, circuitBreakerCooldown *string) error {
+	if timeout != nil {
+		if err := btr.Timeout.UnmarshalText([]byte(*timeout)); err != nil {
+			return errors.Wrap(err, "invalid timeout")
+		}
+	}
+	if retries != nil {
+		if *retries < 0 {
+			return errors.New("retries must not be negative")
+		}
+		btr.Retries = uint32(*retries)
+	}
+	if circuitBreakerThreshold != nil {
+		if *circuitBreakerThreshold < 0 {
+			return errors.New("circuitBreakerThreshold must not be negative")
+		}
+		btr.CircuitBreakerThreshold = uint32(*circuitBreakerThreshold)
+	}
+	if circuitBreakerCooldown != nil {
+		if err := btr.CircuitBreakerCooldown.UnmarshalText([]byte(*circuitBreakerCooldown)); err != nil {
+			return errors.Wrap(err, "invalid circuitBreakerCooldown")
+		}
+	}
+	return nil
+}
+
+// parseBridgeSigningInput sets the optional request signing and response
+// verification settings of a bridge request
+func parseBridgeSigningInput(btr *bridges.BridgeTypeRequest, requestSigning, responsePublicKey *string) {
+	if requestSigning != nil {
+		btr.RequestSigning = bridges.RequestSigning(*requestSigning)
+	}
+	if responsePublicKey != nil {
+		btr.ResponsePublicKey = *responsePublicKey
+	}
+}
+
 // ValidateBridgeType checks that the bridge type doesn't have a duplicate
 // or invalid name or invalid url
 //
@@ -85,6 +139,26 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if bt.CacheTTL.Duration() < 0 {
+		return errors.New("cacheTTL must not be negative")
+	}
+	if bt.StaleIfError.Duration() < 0 {
+		return errors.New("staleIfError must not be negative")
+	}
+	if bt.Timeout.Duration() < 0 {
+		return errors.New("timeout must not be negative")
+	}
+	if bt.CircuitBreakerCooldown.Duration() < 0 {
+		return errors.New("circuitBreakerCooldown must not be negative")
+	}
+	if err := bt.RequestSigning.Validate(); err != nil {
+		return err
+	}
+	if bt.ResponsePublicKey != "" {
+		if _, err := bridges.ParseResponsePublicKey(bt.ResponsePublicKey); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
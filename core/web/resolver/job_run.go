@@ -2,11 +2,13 @@ package resolver
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -170,11 +172,61 @@ func (r *JobRunPayloadResolver) ToJobRun() (*JobRunResolver, bool) {
 	return NewJobRun(*r.jr, r.app), true
 }
 
+// JobRunsFilterInput represents the filter of the jobRuns query
+type JobRunsFilterInput struct {
+	Status         *[]JobRunStatus
+	JobIDs         *[]graphql.ID
+	CreatedAfter   *graphql.Time
+	CreatedBefore  *graphql.Time
+	FailedTaskType *string
+	ErrorContains  *string
+	OutputContains *string
+}
+
+// toFilter converts the input to a job.PipelineRunsFilter. A nil input
+// matches all runs.
+func (i *JobRunsFilterInput) toFilter() (filter job.PipelineRunsFilter, err error) {
+	if i == nil {
+		return filter, nil
+	}
+	if i.Status != nil {
+		for _, status := range *i.Status {
+			filter.States = append(filter.States, pipeline.RunStatus(strings.ToLower(string(status))))
+		}
+	}
+	if i.JobIDs != nil {
+		for _, id := range *i.JobIDs {
+			jobID, err := stringutils.ToInt32(string(id))
+			if err != nil {
+				return filter, errors.Errorf("invalid job ID %q", id)
+			}
+			filter.JobIDs = append(filter.JobIDs, jobID)
+		}
+	}
+	if i.CreatedAfter != nil {
+		filter.CreatedAfter = &i.CreatedAfter.Time
+	}
+	if i.CreatedBefore != nil {
+		filter.CreatedBefore = &i.CreatedBefore.Time
+	}
+	if i.FailedTaskType != nil {
+		filter.FailedTaskType = pipeline.TaskType(*i.FailedTaskType)
+	}
+	if i.ErrorContains != nil {
+		filter.ErrorContains = *i.ErrorContains
+	}
+	if i.OutputContains != nil {
+		filter.OutputContains = *i.OutputContains
+	}
+	return filter, filter.Validate()
+}
+
 // JobRunsPayloadResolver resolves a page of job runs
 type JobRunsPayloadResolver struct {
-	runs  []pipeline.Run
-	total int32
-	app   chainlink.Application
+	runs       []pipeline.Run
+	total      int32
+	nextCursor *job.PipelineRunsCursor
+	app        chainlink.Application
 }
 
 func NewJobRunsPayload(runs []pipeline.Run, total int32, app chainlink.Application) *JobRunsPayloadResolver {
@@ -195,6 +247,15 @@ func (r *JobRunsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
 
+// NextCursor returns the cursor to fetch the next page of runs with, if any.
+func (r *JobRunsPayloadResolver) NextCursor() *string {
+	if r.nextCursor == nil {
+		return nil
+	}
+	cursor := r.nextCursor.String()
+	return &cursor
+}
+
 // -- RunJob Mutation --
 
 type RunJobPayloadResolver struct {
@@ -2,10 +2,13 @@ package resolver
 
 import (
 	"database/sql"
+	"fmt"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
@@ -82,6 +85,114 @@ func TestQuery_PaginatedJobRuns(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestQuery_FilteredJobRuns(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobsRuns($filter: JobRunsFilter, $after: String) {
+			jobRuns(limit: 1, filter: $filter, after: $after) {
+				results {
+					id
+				}
+				metadata {
+					total
+				}
+				nextCursor
+			}
+		}`
+
+	createdAfter := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursor := job.PipelineRunsCursor{CreatedAt: createdAfter.Add(time.Hour), ID: 201}
+	filter := job.PipelineRunsFilter{
+		JobIDs:         []int32{1},
+		States:         []pipeline.RunStatus{pipeline.RunStatusErrored},
+		CreatedAfter:   &createdAfter,
+		FailedTaskType: pipeline.TaskTypeHTTP,
+		ErrorContains:  "timeout",
+	}
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{
+			"jobIDs":         []interface{}{"1"},
+			"status":         []interface{}{"ERRORED"},
+			"createdAfter":   "2022-01-01T00:00:00Z",
+			"failedTaskType": "http",
+			"errorContains":  "timeout",
+		},
+	}
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("SearchPipelineRuns", mock.MatchedBy(func(actual job.PipelineRunsFilter) bool {
+					return assert.ObjectsAreEqual(filter.JobIDs, actual.JobIDs) &&
+						assert.ObjectsAreEqual(filter.States, actual.States) &&
+						actual.CreatedAfter != nil && actual.CreatedAfter.Equal(createdAfter) &&
+						actual.FailedTaskType == filter.FailedTaskType && actual.ErrorContains == filter.ErrorContains
+				}), (*job.PipelineRunsCursor)(nil), 1).Return([]pipeline.Run{{ID: int64(201)}}, &cursor, nil)
+				f.Mocks.jobORM.On("CountPipelineRuns", mock.Anything).Return(2, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     query,
+			variables: variables,
+			result: fmt.Sprintf(`
+				{
+					"jobRuns": {
+						"results": [{
+							"id": "201"
+						}],
+						"metadata": {
+							"total": 2
+						},
+						"nextCursor": "%s"
+					}
+				}`, cursor.String()),
+		},
+		{
+			name:          "next page",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("SearchPipelineRuns", job.PipelineRunsFilter{}, mock.MatchedBy(func(actual *job.PipelineRunsCursor) bool {
+					return actual != nil && actual.ID == cursor.ID && actual.CreatedAt.Equal(cursor.CreatedAt)
+				}), 1).Return([]pipeline.Run{{ID: int64(200)}}, (*job.PipelineRunsCursor)(nil), nil)
+				f.Mocks.jobORM.On("CountPipelineRuns", job.PipelineRunsFilter{}).Return(2, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     query,
+			variables: map[string]interface{}{"after": cursor.String()},
+			result: `
+				{
+					"jobRuns": {
+						"results": [{
+							"id": "200"
+						}],
+						"metadata": {
+							"total": 2
+						},
+						"nextCursor": null
+					}
+				}`,
+		},
+		{
+			name:          "invalid cursor",
+			authenticated: true,
+			query:         query,
+			variables:     map[string]interface{}{"after": "!!!"},
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: errors.New(`invalid cursor "!!!"`),
+					Path:          []interface{}{"jobRuns"},
+					Message:       `invalid cursor "!!!"`,
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_JobRun(t *testing.T) {
 	t.Parallel()
 
@@ -20,9 +20,11 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/blocktrigger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/evmlog"
 	"github.com/smartcontractkit/chainlink/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -49,10 +51,18 @@ type Resolver struct {
 }
 
 type createBridgeInput struct {
-	Name                   string
-	URL                    string
-	Confirmations          int32
-	MinimumContractPayment string
+	Name                    string
+	URL                     string
+	Confirmations           int32
+	MinimumContractPayment  string
+	CacheTTL                *string
+	StaleIfError            *string
+	Timeout                 *string
+	Retries                 *int32
+	CircuitBreakerThreshold *int32
+	CircuitBreakerCooldown  *string
+	RequestSigning          *string
+	ResponsePublicKey       *string
 }
 
 // CreateBridge creates a new bridge.
@@ -80,6 +90,13 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
+	if err := parseBridgeCacheInput(btr, args.Input.CacheTTL, args.Input.StaleIfError); err != nil {
+		return nil, err
+	}
+	if err := parseBridgeResilienceInput(btr, args.Input.Timeout, args.Input.Retries, args.Input.CircuitBreakerThreshold, args.Input.CircuitBreakerCooldown); err != nil {
+		return nil, err
+	}
+	parseBridgeSigningInput(btr, args.Input.RequestSigning, args.Input.ResponsePublicKey)
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	if err != nil {
@@ -196,10 +213,18 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 }
 
 type updateBridgeInput struct {
-	Name                   string
-	URL                    string
-	Confirmations          int32
-	MinimumContractPayment string
+	Name                    string
+	URL                     string
+	Confirmations           int32
+	MinimumContractPayment  string
+	CacheTTL                *string
+	StaleIfError            *string
+	Timeout                 *string
+	Retries                 *int32
+	CircuitBreakerThreshold *int32
+	CircuitBreakerCooldown  *string
+	RequestSigning          *string
+	ResponsePublicKey       *string
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -229,6 +254,13 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
+	if err := parseBridgeCacheInput(btr, args.Input.CacheTTL, args.Input.StaleIfError); err != nil {
+		return nil, err
+	}
+	if err := parseBridgeResilienceInput(btr, args.Input.Timeout, args.Input.Retries, args.Input.CircuitBreakerThreshold, args.Input.CircuitBreakerCooldown); err != nil {
+		return nil, err
+	}
+	parseBridgeSigningInput(btr, args.Input.RequestSigning, args.Input.ResponsePublicKey)
 
 	taskType, err := bridges.NewTaskType(string(args.ID))
 	if err != nil {
@@ -979,6 +1011,10 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
 	case job.Bootstrap:
 		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(args.Input.TOML)
+	case job.EVMLog:
+		jb, err = evmlog.ValidatedEVMLogSpec(args.Input.TOML)
+	case job.BlockTrigger:
+		jb, err = blocktrigger.ValidatedBlockTriggerSpec(args.Input.TOML)
 	default:
 		return NewCreateJobPayload(r.App, nil, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
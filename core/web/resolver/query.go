@@ -12,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
@@ -337,6 +338,8 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
+	Filter *JobRunsFilterInput
+	After  *string
 }) (*JobRunsPayloadResolver, error) {
 	if err := authenticateUser(ctx); err != nil {
 		return nil, err
@@ -345,12 +348,41 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	limit := pageLimit(args.Limit)
 	offset := pageOffset(args.Offset)
 
-	runs, count, err := r.App.JobORM().PipelineRuns(nil, offset, limit)
+	if args.Filter == nil && args.After == nil {
+		runs, count, err := r.App.JobORM().PipelineRuns(nil, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewJobRunsPayload(runs, int32(count), r.App), nil
+	}
+
+	// Filtered runs are paginated with a cursor rather than an offset
+	filter, err := args.Filter.toFilter()
+	if err != nil {
+		return nil, err
+	}
+	var after *job.PipelineRunsCursor
+	if args.After != nil {
+		cursor, err := job.ParsePipelineRunsCursor(*args.After)
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor
+	}
+
+	runs, next, err := r.App.JobORM().SearchPipelineRuns(filter, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	count, err := r.App.JobORM().CountPipelineRuns(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewJobRunsPayload(runs, int32(count), r.App), nil
+	payload := NewJobRunsPayload(runs, int32(count), r.App)
+	payload.nextCursor = next
+	return payload, nil
 }
 
 func (r *Resolver) JobRun(ctx context.Context, args struct {
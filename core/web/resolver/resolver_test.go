@@ -16,6 +16,7 @@ import (
 	evmORMMocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
 	configMocks "github.com/smartcontractkit/chainlink/core/config/mocks"
 	coremocks "github.com/smartcontractkit/chainlink/core/internal/mocks"
+	bridgeHealthMocks "github.com/smartcontractkit/chainlink/core/services/bridgehealth/mocks"
 	feedsMocks "github.com/smartcontractkit/chainlink/core/services/feeds/mocks"
 	jobORMMocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
@@ -30,6 +31,7 @@ import (
 
 type mocks struct {
 	bridgeORM   *bridgeORMMocks.ORM
+	bridgeHlth  *bridgeHealthMocks.Monitor
 	evmORM      *evmORMMocks.ORM
 	jobORM      *jobORMMocks.ORM
 	sessionsORM *sessionsMocks.ORM
@@ -87,6 +89,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 	// Note - If you add a new mock make sure you assert it's expectation below.
 	m := &mocks{
 		bridgeORM:   &bridgeORMMocks.ORM{},
+		bridgeHlth:  &bridgeHealthMocks.Monitor{},
 		evmORM:      &evmORMMocks.ORM{},
 		jobORM:      &jobORMMocks.ORM{},
 		feedsSvc:    &feedsMocks.Service{},
@@ -115,6 +118,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		mock.AssertExpectationsForObjects(t,
 			app,
 			m.bridgeORM,
+			m.bridgeHlth,
 			m.evmORM,
 			m.jobORM,
 			m.sessionsORM,
@@ -99,6 +99,24 @@ func (r *SpecResolver) ToBootstrapSpec() (*BootstrapSpecResolver, bool) {
 	return &BootstrapSpecResolver{spec: *r.j.BootstrapSpec}, true
 }
 
+// ToEVMLogSpec resolves to the EVMLog Spec Resolver
+func (r *SpecResolver) ToEVMLogSpec() (*EVMLogSpecResolver, bool) {
+	if r.j.Type != job.EVMLog {
+		return nil, false
+	}
+
+	return &EVMLogSpecResolver{spec: *r.j.EVMLogSpec}, true
+}
+
+// ToBlockTriggerSpec resolves to the BlockTrigger Spec Resolver
+func (r *SpecResolver) ToBlockTriggerSpec() (*BlockTriggerSpecResolver, bool) {
+	if r.j.Type != job.BlockTrigger {
+		return nil, false
+	}
+
+	return &BlockTriggerSpecResolver{spec: *r.j.BlockTriggerSpec}, true
+}
+
 type CronSpecResolver struct {
 	spec job.CronSpec
 }
@@ -107,6 +125,26 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Jitter resolves the spec's maximum random delay of a run.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.String()
+}
+
+// MaxConcurrentRuns resolves the spec's maximum number of concurrent runs.
+func (r *CronSpecResolver) MaxConcurrentRuns() int32 {
+	return int32(r.spec.MaxConcurrentRuns)
+}
+
+// ConcurrencyPolicy resolves the spec's concurrency policy.
+func (r *CronSpecResolver) ConcurrencyPolicy() string {
+	return string(r.spec.ConcurrencyPolicy)
+}
+
+// CatchUp resolves whether the job runs the missed schedules.
+func (r *CronSpecResolver) CatchUp() bool {
+	return r.spec.CatchUp
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -663,6 +701,21 @@ func (r *WebhookSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
 }
 
+// InputSchema resolves the spec's JSON Schema of the request body.
+func (r *WebhookSpecResolver) InputSchema() *string {
+	return r.spec.InputSchema.Ptr()
+}
+
+// Sync resolves whether a run request waits for the run to finish.
+func (r *WebhookSpecResolver) Sync() bool {
+	return r.spec.Sync
+}
+
+// SyncTimeout resolves the spec's maximum wait for a run to finish.
+func (r *WebhookSpecResolver) SyncTimeout() string {
+	return r.spec.SyncTimeout.String()
+}
+
 // BlockhashStoreSpecResolver exposes the job parameters for a BlockhashStoreSpec.
 type BlockhashStoreSpecResolver struct {
 	spec job.BlockhashStoreSpec
@@ -803,3 +856,80 @@ func (r *BootstrapSpecResolver) ContractConfigConfirmations() *int32 {
 func (r *BootstrapSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
 }
+
+// EVMLogSpecResolver defines the EVMLog Spec Resolver
+type EVMLogSpecResolver struct {
+	spec job.EVMLogSpec
+}
+
+// ContractAddress resolves the spec's contract address.
+func (r *EVMLogSpecResolver) ContractAddress() string {
+	return r.spec.ContractAddress.String()
+}
+
+// EventABI resolves the spec's event ABI signature.
+func (r *EVMLogSpecResolver) EventABI() string {
+	return r.spec.EventABI
+}
+
+// TopicFilters resolves the spec's indexed topic filters.
+func (r *EVMLogSpecResolver) TopicFilters() gqlscalar.Map {
+	filters := gqlscalar.Map{}
+	for name, values := range r.spec.TopicFilters {
+		filters[name] = values
+	}
+	return filters
+}
+
+// MinIncomingConfirmations resolves the spec's min incoming confirmations.
+func (r *EVMLogSpecResolver) MinIncomingConfirmations() *int32 {
+	if !r.spec.MinIncomingConfirmations.Valid {
+		return nil
+	}
+
+	confirmations := int32(r.spec.MinIncomingConfirmations.Uint32)
+
+	return &confirmations
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *EVMLogSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *EVMLogSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
+
+// BlockTriggerSpecResolver defines the BlockTrigger Spec Resolver
+type BlockTriggerSpecResolver struct {
+	spec job.BlockTriggerSpec
+}
+
+// BlockInterval resolves the spec's block interval.
+func (r *BlockTriggerSpecResolver) BlockInterval() int32 {
+	return int32(r.spec.BlockInterval)
+}
+
+// EVMChainID resolves the spec's evm chain id.
+func (r *BlockTriggerSpecResolver) EVMChainID() *string {
+	if r.spec.EVMChainID == nil {
+		return nil
+	}
+
+	chainID := r.spec.EVMChainID.String()
+
+	return &chainID
+}
+
+// CreatedAt resolves the spec's created at timestamp.
+func (r *BlockTriggerSpecResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.spec.CreatedAt}
+}
@@ -37,8 +37,12 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:      "CRON_TZ=UTC 0 0 1 1 *",
+						Jitter:            30 * time.Second,
+						MaxConcurrentRuns: 1,
+						ConcurrencyPolicy: job.CronConcurrencyQueue,
+						CatchUp:           true,
+						CreatedAt:         f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -50,6 +54,10 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									jitter
+									maxConcurrentRuns
+									concurrencyPolicy
+									catchUp
 									createdAt
 								}
 							}
@@ -63,6 +71,10 @@ func TestResolver_CronSpec(t *testing.T) {
 						"spec": {
 							"__typename": "CronSpec",
 							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"jitter": "30s",
+							"maxConcurrentRuns": 1,
+							"concurrencyPolicy": "queue",
+							"catchUp": true,
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -665,7 +677,10 @@ func TestResolver_WebhookSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
 					Type: job.Webhook,
 					WebhookSpec: &job.WebhookSpec{
-						CreatedAt: f.Timestamp(),
+						InputSchema: null.StringFrom(`{"type":"object"}`),
+						Sync:        true,
+						SyncTimeout: 30 * time.Second,
+						CreatedAt:   f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -677,6 +692,9 @@ func TestResolver_WebhookSpec(t *testing.T) {
 								__typename
 								... on WebhookSpec {
 									createdAt
+									inputSchema
+									sync
+									syncTimeout
 								}
 							}
 						}
@@ -688,7 +706,10 @@ func TestResolver_WebhookSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "WebhookSpec",
-							"createdAt": "2021-01-01T00:00:00Z"
+							"createdAt": "2021-01-01T00:00:00Z",
+							"inputSchema": "{\"type\":\"object\"}",
+							"sync": true,
+							"syncTimeout": "30s"
 						}
 					}
 				}
@@ -856,3 +877,122 @@ func TestResolver_BootstrapSpec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_EVMLogSpec(t *testing.T) {
+	var (
+		id = int32(1)
+	)
+	contractAddress, err := ethkey.NewEIP55Address("0x613a38AC1659769640aaE063C651F48E0250454C")
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "evmlog spec",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
+					Type: job.EVMLog,
+					EVMLogSpec: &job.EVMLogSpec{
+						ContractAddress:          contractAddress,
+						EventABI:                 "Transfer(address indexed from, address indexed to, uint256 value)",
+						TopicFilters:             job.TopicFilters{"to": {"0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"}},
+						MinIncomingConfirmations: clnull.Uint32From(3),
+						EVMChainID:               utils.NewBigI(42),
+						CreatedAt:                f.Timestamp(),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on EVMLogSpec {
+									contractAddress
+									eventABI
+									topicFilters
+									minIncomingConfirmations
+									evmChainID
+									createdAt
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "EVMLogSpec",
+							"contractAddress": "0x613a38AC1659769640aaE063C651F48E0250454C",
+							"eventABI": "Transfer(address indexed from, address indexed to, uint256 value)",
+							"topicFilters": {"to": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]},
+							"minIncomingConfirmations": 3,
+							"evmChainID": "42",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_BlockTriggerSpec(t *testing.T) {
+	var (
+		id = int32(1)
+	)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "block trigger spec",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
+					Type: job.BlockTrigger,
+					BlockTriggerSpec: &job.BlockTriggerSpec{
+						BlockInterval: 10,
+						EVMChainID:    utils.NewBigI(42),
+						CreatedAt:     f.Timestamp(),
+					},
+				}, nil)
+			},
+			query: `
+				query GetJob {
+					job(id: "1") {
+						... on Job {
+							spec {
+								__typename
+								... on BlockTriggerSpec {
+									blockInterval
+									evmChainID
+									createdAt
+								}
+							}
+						}
+					}
+				}
+			`,
+			result: `
+				{
+					"job": {
+						"spec": {
+							"__typename": "BlockTriggerSpec",
+							"blockInterval": 10,
+							"evmChainID": "42",
+							"createdAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}
+			`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -60,3 +60,19 @@ func (r *TaskRunResolver) FinishedAt() *graphql.Time {
 func (r *TaskRunResolver) DotID() string {
 	return r.tr.GetDotID()
 }
+
+func (r *TaskRunResolver) Skipped() bool {
+	return r.tr.Skipped
+}
+
+func (r *TaskRunResolver) Details() *string {
+	if !r.tr.Details.Valid {
+		return nil
+	}
+	val, err := r.tr.Details.MarshalJSON()
+	if err != nil {
+		return nil
+	}
+	details := string(val)
+	return &details
+}
@@ -251,6 +251,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
 		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
 
+		sc := SecretsController{app}
+		authv2.GET("/secrets", sc.Index)
+		authv2.POST("/secrets", sc.Create)
+		authv2.PATCH("/secrets/:name", sc.Update)
+		authv2.DELETE("/secrets/:name", sc.Delete)
+
 		ts := TransfersController{app}
 		authv2.POST("/transfers", ts.Create)
 
@@ -340,6 +346,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/pipeline/runs/:runID/rerun", prc.Rerun)
+		authv2.POST("/pipeline/runs/:runID/resume", prc.ResumeSuspended)
+		authv2.POST("/pipeline/runs/:runID/fail", prc.FailSuspended)
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -359,6 +368,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.PATCH("/chains/evm/:ID", echc.Update)
 		authv2.DELETE("/chains/evm/:ID", echc.Delete)
 
+		ehc := EVMHeadsController{app}
+		authv2.GET("/chains/evm/:ID/heads", paginatedRequest(ehc.Index))
+
 		tchc := TerraChainsController{app}
 		authv2.GET("/chains/terra", paginatedRequest(tchc.Index))
 		authv2.POST("/chains/terra", tchc.Create)
@@ -0,0 +1,97 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// SecretRequest is the request body for creating or updating a secret
+type SecretRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SecretsController manages node secrets. Secret values can be written but
+// are never returned.
+type SecretsController struct {
+	App chainlink.Application
+}
+
+// Index lists the names of all secrets
+// Example:
+// "GET <application>/secrets"
+func (sc *SecretsController) Index(c *gin.Context) {
+	secrets, err := sc.App.GetKeyStore().Secrets().GetAll()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSecretResources(secrets), "secrets")
+}
+
+// Create stores a new secret
+// Example:
+// "POST <application>/secrets"
+func (sc *SecretsController) Create(c *gin.Context) {
+	var request SecretRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := keystore.ValidateSecretName(request.Name); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	secret, err := sc.App.GetKeyStore().Secrets().Create(request.Name, request.Value)
+	if errors.Is(err, keystore.ErrSecretExists) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewSecretResource(secret), "secret", http.StatusCreated)
+}
+
+// Update replaces the value of an existing secret
+// Example:
+// "PATCH <application>/secrets/:name"
+func (sc *SecretsController) Update(c *gin.Context) {
+	var request SecretRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	secret, err := sc.App.GetKeyStore().Secrets().Update(c.Param("name"), request.Value)
+	if errors.Is(err, keystore.ErrSecretNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSecretResource(secret), "secret")
+}
+
+// Delete removes a secret
+// Example:
+// "DELETE <application>/secrets/:name"
+func (sc *SecretsController) Delete(c *gin.Context) {
+	secret, err := sc.App.GetKeyStore().Secrets().Delete(c.Param("name"))
+	if errors.Is(err, keystore.ErrSecretNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewSecretResource(secret), "secret")
+}
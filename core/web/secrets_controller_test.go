@@ -0,0 +1,110 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestSecretsController_Create(t *testing.T) {
+	client, secrets := setupSecretsControllerTests(t)
+
+	body, err := json.Marshal(web.SecretRequest{Name: "apiKey", Value: "s3cr3t"})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/secrets", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusCreated)
+
+	responseBody := cltest.ParseResponseBody(t, response)
+	assert.NotContains(t, string(responseBody), "s3cr3t")
+	resource := presenters.SecretResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(responseBody, &resource))
+	assert.Equal(t, "apiKey", resource.Name)
+
+	values, err := secrets.GetAllValues()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"apiKey": "s3cr3t"}, values)
+
+	t.Run("duplicate name", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/secrets", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusConflict, response.StatusCode)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		body, err := json.Marshal(web.SecretRequest{Name: "api-key", Value: "s3cr3t"})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/secrets", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+	})
+}
+
+func TestSecretsController_Index(t *testing.T) {
+	client, secrets := setupSecretsControllerTests(t)
+
+	_, err := secrets.Create("b", "value-b")
+	require.NoError(t, err)
+	_, err = secrets.Create("a", "value-a")
+	require.NoError(t, err)
+
+	response, cleanup := client.Get("/v2/secrets")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	responseBody := cltest.ParseResponseBody(t, response)
+	assert.NotContains(t, string(responseBody), "value-")
+	resources := []presenters.SecretResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(responseBody, &resources))
+	require.Len(t, resources, 2)
+	assert.Equal(t, "a", resources[0].Name)
+	assert.Equal(t, "b", resources[1].Name)
+}
+
+func TestSecretsController_UpdateAndDelete(t *testing.T) {
+	client, secrets := setupSecretsControllerTests(t)
+
+	_, err := secrets.Create("apiKey", "old")
+	require.NoError(t, err)
+
+	body, err := json.Marshal(web.SecretRequest{Value: "new"})
+	require.NoError(t, err)
+	response, cleanup := client.Patch("/v2/secrets/apiKey", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	values, err := secrets.GetAllValues()
+	require.NoError(t, err)
+	assert.Equal(t, "new", values["apiKey"])
+
+	response, cleanup = client.Delete("/v2/secrets/apiKey")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	all, err := secrets.GetAll()
+	require.NoError(t, err)
+	assert.Len(t, all, 0)
+
+	response, cleanup = client.Delete("/v2/secrets/apiKey")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func setupSecretsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, keystore.Secrets) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	return client, app.GetKeyStore().Secrets()
+}